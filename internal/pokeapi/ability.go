@@ -0,0 +1,36 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+type AbilityPokemon struct {
+	Pokemon  NamedAPIResource `json:"pokemon"`
+	Slot     int              `json:"slot"`
+	IsHidden bool             `json:"is_hidden"`
+}
+
+type AbilityResponse struct {
+	Generation    NamedAPIResource `json:"generation"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Pokemon       []AbilityPokemon `json:"pokemon"`
+	Name          string           `json:"name"`
+	ID            int              `json:"id"`
+	IsMainSeries  bool             `json:"is_main_series"`
+}
+
+// Effect returns the full effect text in the given language.
+func (r *AbilityResponse) Effect(language string) string {
+	for _, entry := range r.EffectEntries {
+		if entry.Language.Name == language {
+			return strings.Join(strings.Fields(entry.Effect), " ")
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) GetAbility(ctx context.Context, abilityName string) (AbilityResponse, error) {
+	return getResource[AbilityResponse](ctx, c, "ability", abilityName)
+}
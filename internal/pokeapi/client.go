@@ -1,21 +1,176 @@
 package pokeapi
 
 import (
-	"github.com/bekadoux/pokedex/internal/pokecache"
+	"fmt"
+	"log"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
+
+	"github.com/bekadoux/pokedex/internal/pokecache"
 )
 
 type Client struct {
+	baseURL    string
 	httpClient http.Client
-	cache      pokecache.Cache
+	retry      RetryPolicy
+	limiter    *rateLimiter
+	logger     *log.Logger
+	cache      *pokecache.Cache
+	disk       *pokecache.DiskCache
+}
+
+// Option configures optional Client behavior.
+type Option func(*Client)
+
+// WithDiskCache adds a persistent cache tier consulted after the in-memory
+// cache and before the network.
+func WithDiskCache(disk *pokecache.DiskCache) Option {
+	return func(c *Client) {
+		c.disk = disk
+	}
 }
 
-func NewClient(timeout time.Duration) Client {
-	return Client{
+// WithBaseURL points the client at a PokeAPI mirror, e.g.
+// "http://localhost:8000/api/v2".
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithLogger enables debug output such as requests sent, rate limiter waits
+// and retries.
+func WithLogger(logger *log.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+func NewClient(timeout time.Duration, opts ...Option) Client {
+	client := Client{
+		baseURL: DefaultBaseURL,
+		retry:   DefaultRetryPolicy,
+		limiter: newRateLimiter(DefaultRateLimit, DefaultRateBurst),
 		httpClient: http.Client{
 			Timeout: timeout,
 		},
-		cache: pokecache.NewCache(cacheInterval),
+		cache: pokecache.NewCache(
+			cacheInterval,
+			pokecache.WithMaxEntries(cacheMaxEntries),
+			pokecache.WithMaxBytes(cacheMaxBytes),
+		),
+	}
+	for _, opt := range opts {
+		opt(&client)
+	}
+
+	return client
+}
+
+// Close releases background resources held by the client.
+func (c *Client) Close() {
+	c.cache.Close()
+}
+
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// resolveURL turns a URL found in a response (such as a pagination link) into
+// one served by the configured base URL. Relative references are resolved
+// against the base URL, and links to the public PokeAPI are rewritten when a
+// mirror is in use, since mirrors often serve upstream documents verbatim.
+func (c *Client) resolveURL(rawURL string) (string, error) {
+	ref, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid resource URL '%s': %w", rawURL, err)
+	}
+
+	if !ref.IsAbs() {
+		base, err := url.Parse(c.baseURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid base URL '%s': %w", c.baseURL, err)
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+
+	if c.baseURL != DefaultBaseURL {
+		if rest, ok := strings.CutPrefix(rawURL, DefaultBaseURL); ok {
+			return c.baseURL + rest, nil
+		}
+	}
+
+	return rawURL, nil
+}
+
+type CacheStats struct {
+	Memory      pokecache.Stats
+	Disk        pokecache.DiskStats
+	DiskDir     string
+	DiskEnabled bool
+}
+
+func (c *Client) CacheStats() (CacheStats, error) {
+	stats := CacheStats{
+		Memory: c.cache.Stats(),
+	}
+	if c.disk == nil {
+		return stats, nil
+	}
+
+	diskStats, err := c.disk.Stats()
+	if err != nil {
+		return CacheStats{}, fmt.Errorf("error reading disk cache: %w", err)
+	}
+	stats.Disk = diskStats
+	stats.DiskDir = c.disk.Dir()
+	stats.DiskEnabled = true
+
+	return stats, nil
+}
+
+func (c *Client) ClearCache() error {
+	c.cache.Clear()
+	if c.disk == nil {
+		return nil
+	}
+
+	err := c.disk.Clear()
+	if err != nil {
+		return fmt.Errorf("error clearing disk cache: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) logf(format string, args ...any) {
+	if c.logger != nil {
+		c.logger.Printf(format, args...)
+	}
+}
+
+func (c *Client) cacheGet(url string) ([]byte, bool) {
+	if data, ok := c.cache.Get(url); ok {
+		return data, true
+	}
+	if c.disk == nil {
+		return nil, false
+	}
+
+	data, ok := c.disk.Get(url)
+	if ok {
+		c.cache.Add(url, data)
+	}
+
+	return data, ok
+}
+
+func (c *Client) cacheAdd(url string, data []byte) {
+	c.cache.Add(url, data)
+	if c.disk != nil {
+		// The disk tier is best effort; a failed write only costs a refetch
+		_ = c.disk.Add(url, data)
 	}
 }
@@ -0,0 +1,151 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestCloseLeavesNoGoroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for range 5 {
+		client := NewClient(time.Second)
+		client.Close()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d goroutines after close, got %d", before, runtime.NumGoroutine())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBaseURL(t *testing.T) {
+	mux := http.NewServeMux()
+	var serverURL string
+	mux.HandleFunc("/api/v2/location-area", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			// Relative pagination link
+			fmt.Fprint(w, `{"count": 3, "next": "/api/v2/location-area?offset=1", "results": [{"name": "first-area"}]}`)
+		case "1":
+			// Link copied verbatim from the public API
+			fmt.Fprintf(w, `{"count": 3, "next": "%s/location-area?offset=2", "previous": "%s/api/v2/location-area", "results": [{"name": "second-area"}]}`, DefaultBaseURL, serverURL)
+		default:
+			fmt.Fprint(w, `{"count": 3, "results": [{"name": "third-area"}]}`)
+		}
+	})
+	mux.HandleFunc("/api/v2/location-area/first-area", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "first-area", "pokemon_encounters": [{"pokemon": {"name": "pidgey"}}]}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	serverURL = server.URL
+
+	client := NewClient(time.Second, WithBaseURL(server.URL+"/api/v2/"))
+	defer client.Close()
+	ctx := context.Background()
+
+	expected := []string{"first-area", "second-area", "third-area"}
+	next := ""
+	for i, name := range expected {
+		page, err := client.GetLocationAreas(ctx, next)
+		if err != nil {
+			t.Fatalf("page %d: unexpected error: %v", i, err)
+		}
+		if len(page.Results) != 1 || page.Results[0].Name != name {
+			t.Fatalf("page %d: expected '%s', got %v", i, name, page.Results)
+		}
+		if i == 1 {
+			previous, err := client.GetLocationAreas(ctx, page.Previous)
+			if err != nil {
+				t.Fatalf("unexpected error following previous link: %v", err)
+			}
+			if previous.Results[0].Name != "first-area" {
+				t.Errorf("expected previous page to be first-area, got %v", previous.Results)
+			}
+		}
+		next = page.Next
+	}
+
+	details, err := client.GetLocationDetails(ctx, "first-area")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(details.PokemonEncounters) != 1 || details.PokemonEncounters[0].Pokemon.Name != "pidgey" {
+		t.Errorf("unexpected encounters: %v", details.PokemonEncounters)
+	}
+
+	if _, err := client.GetPokemonDetails(ctx, "missingno"); err == nil {
+		t.Errorf("expected error for unknown pokemon")
+	}
+}
+
+func TestResolveURL(t *testing.T) {
+	cases := []struct {
+		baseURL  string
+		input    string
+		expected string
+	}{
+		{
+			baseURL:  DefaultBaseURL,
+			input:    DefaultBaseURL + "/pokemon/1/",
+			expected: DefaultBaseURL + "/pokemon/1/",
+		},
+		{
+			baseURL:  "http://localhost:8000/api/v2",
+			input:    DefaultBaseURL + "/pokemon/1/",
+			expected: "http://localhost:8000/api/v2/pokemon/1/",
+		},
+		{
+			baseURL:  "http://localhost:8000/api/v2",
+			input:    "/api/v2/pokemon/?offset=20&limit=20",
+			expected: "http://localhost:8000/api/v2/pokemon/?offset=20&limit=20",
+		},
+		{
+			baseURL:  "http://localhost:8000/api/v2",
+			input:    "http://other.example/api/v2/pokemon/1/",
+			expected: "http://other.example/api/v2/pokemon/1/",
+		},
+	}
+
+	for _, c := range cases {
+		client := NewClient(time.Second, WithBaseURL(c.baseURL))
+		actual, err := client.resolveURL(c.input)
+		client.Close()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if actual != c.expected {
+			t.Errorf("resolveURL(%s) with base %s: expected '%s', got '%s'", c.input, c.baseURL, c.expected, actual)
+		}
+	}
+}
+
+func TestResourceID(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected int
+		ok       bool
+	}{
+		{input: DefaultBaseURL + "/evolution-chain/10/", expected: 10, ok: true},
+		{input: "http://localhost:8000/api/v2/pokemon-species/25", expected: 25, ok: true},
+		{input: DefaultBaseURL + "/pokemon/pikachu/", ok: false},
+		{input: "", ok: false},
+	}
+
+	for _, c := range cases {
+		actual, err := ResourceID(c.input)
+		if (err == nil) != c.ok || actual != c.expected {
+			t.Errorf("ResourceID(%s): expected (%d, %v), got (%d, %v)", c.input, c.expected, c.ok, actual, err)
+		}
+	}
+}
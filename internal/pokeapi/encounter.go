@@ -1,5 +1,10 @@
 package pokeapi
 
+import (
+	"context"
+	"math/rand"
+)
+
 type Encounter struct {
 	Method          NamedAPIResource   `json:"method"`
 	ConditionValues []NamedAPIResource `json:"condition_values"`
@@ -28,3 +33,72 @@ type VersionEncounterDetail struct {
 	EncounterDetails []Encounter      `json:"encounter_details"`
 	MaxChance        int              `json:"max_chance"`
 }
+
+type LocationAreaEncounter struct {
+	LocationArea   NamedAPIResource         `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// GetPokemonEncounters resolves the Pokémon's location_area_encounters link,
+// listing every area it can be found in.
+func (c *Client) GetPokemonEncounters(ctx context.Context, pokemonName string) ([]LocationAreaEncounter, error) {
+	pokemon, err := c.GetPokemonDetails(ctx, pokemonName)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.resolveURL(pokemon.LocationAreaEncounters)
+	if err != nil {
+		return nil, err
+	}
+
+	return get[[]LocationAreaEncounter](ctx, c, url)
+}
+
+// WildEncounter is a Pokémon met in the wild together with the encounter
+// slot it was rolled from.
+type WildEncounter struct {
+	Pokemon   NamedAPIResource
+	Version   NamedAPIResource
+	Encounter Encounter
+}
+
+// RollEncounter picks a wild encounter from an area's encounter slots, each
+// weighted by its Chance. Only slots of version are considered unless it is
+// empty. It returns false if there is nothing to encounter.
+func RollEncounter(encounters []PokemonEncounter, version string) (WildEncounter, bool) {
+	slots := []WildEncounter{}
+	total := 0
+	for _, pokemonEncounter := range encounters {
+		for _, versionDetail := range pokemonEncounter.VersionDetails {
+			if version != "" && versionDetail.Version.Name != version {
+				continue
+			}
+			for _, encounter := range versionDetail.EncounterDetails {
+				if encounter.Chance <= 0 {
+					continue
+				}
+				slots = append(slots, WildEncounter{
+					Pokemon:   pokemonEncounter.Pokemon,
+					Version:   versionDetail.Version,
+					Encounter: encounter,
+				})
+				total += encounter.Chance
+			}
+		}
+	}
+
+	if total == 0 {
+		return WildEncounter{}, false
+	}
+
+	roll := rand.Intn(total)
+	for _, slot := range slots {
+		roll -= slot.Encounter.Chance
+		if roll < 0 {
+			return slot, true
+		}
+	}
+
+	return slots[len(slots)-1], true
+}
@@ -0,0 +1,91 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetPokemonEncounters(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/pokemon/pikachu", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name": "pikachu", "location_area_encounters": "%s/pokemon/25/encounters"}`, DefaultBaseURL)
+	})
+	mux.HandleFunc("/api/v2/pokemon/25/encounters", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{
+			"location_area": {"name": "viridian-forest-area"},
+			"version_details": [{
+				"version": {"name": "red"},
+				"max_chance": 5,
+				"encounter_details": [{"method": {"name": "walk"}, "chance": 5, "min_level": 3, "max_level": 5}]
+			}]
+		}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClient(time.Second, WithBaseURL(server.URL+"/api/v2"))
+	defer client.Close()
+
+	areas, err := client.GetPokemonEncounters(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(areas) != 1 || areas[0].LocationArea.Name != "viridian-forest-area" {
+		t.Fatalf("unexpected areas: %v", areas)
+	}
+	details := areas[0].VersionDetails[0].EncounterDetails
+	if len(details) != 1 || details[0].MinLevel != 3 || details[0].MaxLevel != 5 {
+		t.Errorf("unexpected encounter details: %v", details)
+	}
+}
+
+func TestRollEncounter(t *testing.T) {
+	slot := func(version, method string, chance int) VersionEncounterDetail {
+		return VersionEncounterDetail{
+			Version:          NamedAPIResource{Name: version},
+			EncounterDetails: []Encounter{{Method: NamedAPIResource{Name: method}, Chance: chance, MinLevel: 2, MaxLevel: 4}},
+		}
+	}
+	encounters := []PokemonEncounter{
+		{
+			Pokemon:        NamedAPIResource{Name: "pidgey"},
+			VersionDetails: []VersionEncounterDetail{slot("red", "walk", 75), slot("blue", "walk", 0)},
+		},
+		{
+			Pokemon:        NamedAPIResource{Name: "rattata"},
+			VersionDetails: []VersionEncounterDetail{slot("red", "walk", 25), slot("blue", "walk", 40)},
+		},
+	}
+
+	counts := make(map[string]int)
+	const rolls = 4000
+	for range rolls {
+		wild, ok := RollEncounter(encounters, "red")
+		if !ok {
+			t.Fatalf("expected an encounter")
+		}
+		if wild.Version.Name != "red" {
+			t.Fatalf("expected only red encounters, got %s", wild.Version.Name)
+		}
+		counts[wild.Pokemon.Name]++
+	}
+	// 75% expected; allow generous slack to keep the test stable
+	if ratio := float64(counts["pidgey"]) / rolls; ratio < 0.65 || ratio > 0.85 {
+		t.Errorf("expected pidgey about 75%% of the time, got %v", ratio)
+	}
+
+	for range 100 {
+		wild, ok := RollEncounter(encounters, "blue")
+		if !ok || wild.Pokemon.Name != "rattata" {
+			t.Fatalf("expected only rattata in blue, got %v", wild.Pokemon.Name)
+		}
+	}
+
+	if _, ok := RollEncounter(encounters, "gold"); ok {
+		t.Errorf("expected no encounter in a version without slots")
+	}
+}
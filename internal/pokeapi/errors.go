@@ -0,0 +1,42 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound matches any lookup of a resource PokeAPI does not know about.
+var ErrNotFound = errors.New("not found")
+
+// HTTPError is returned for responses with an unsuccessful status code.
+// A 404 HTTPError matches ErrNotFound.
+type HTTPError struct {
+	URL        string
+	retryAfter string
+	StatusCode int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("response failed with status code: %d", e.StatusCode)
+}
+
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// NotFoundError reports which named resource, e.g. a "pokemon" or a
+// "location-area", does not exist. It matches ErrNotFound.
+type NotFoundError struct {
+	Err      error
+	Resource string
+	Name     string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found: '%s'", e.Resource, e.Name)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,55 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestErrorKinds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/pokemon/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClient(time.Second, WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	defer client.Close()
+	ctx := context.Background()
+
+	_, err := client.GetPokemonDetails(ctx, "pikachuu")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) || notFound.Resource != "pokemon" || notFound.Name != "pikachuu" {
+		t.Errorf("expected pokemon *NotFoundError, got %v", err)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected error to match ErrNotFound, got %v", err)
+	}
+
+	_, err = client.GetLocationDetails(ctx, "nowhere")
+	if !errors.As(err, &notFound) || notFound.Resource != "location-area" {
+		t.Errorf("expected location-area *NotFoundError, got %v", err)
+	}
+
+	_, err = client.GetPokemonDetails(ctx, "broken")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected *HTTPError with status 500, got %v", err)
+	}
+	if httpErr != nil && httpErr.URL != server.URL+"/pokemon/broken" {
+		t.Errorf("expected URL in *HTTPError, got '%s'", httpErr.URL)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("expected status 500 to not match ErrNotFound")
+	}
+
+	if _, err := client.GetPokemonDetails(ctx, ""); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("expected validation error for empty name, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"context"
+	"strconv"
+)
+
+type EvolutionChainResponse struct {
+	BabyTriggerItem NamedAPIResource `json:"baby_trigger_item"`
+	Chain           ChainLink        `json:"chain"`
+	ID              int              `json:"id"`
+}
+
+// ChainLink is one species in an evolution chain together with the species
+// it can evolve into.
+type ChainLink struct {
+	Species          NamedAPIResource  `json:"species"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+	IsBaby           bool              `json:"is_baby"`
+}
+
+// EvolutionDetail describes one way to evolve into a species. Zero values
+// mean the condition does not apply.
+type EvolutionDetail struct {
+	Trigger               NamedAPIResource `json:"trigger"`
+	Item                  NamedAPIResource `json:"item"`
+	HeldItem              NamedAPIResource `json:"held_item"`
+	KnownMove             NamedAPIResource `json:"known_move"`
+	KnownMoveType         NamedAPIResource `json:"known_move_type"`
+	Location              NamedAPIResource `json:"location"`
+	PartySpecies          NamedAPIResource `json:"party_species"`
+	PartyType             NamedAPIResource `json:"party_type"`
+	TradeSpecies          NamedAPIResource `json:"trade_species"`
+	RelativePhysicalStats *int             `json:"relative_physical_stats"`
+	TimeOfDay             string           `json:"time_of_day"`
+	Gender                int              `json:"gender"`
+	MinLevel              int              `json:"min_level"`
+	MinHappiness          int              `json:"min_happiness"`
+	MinBeauty             int              `json:"min_beauty"`
+	MinAffection          int              `json:"min_affection"`
+	NeedsOverworldRain    bool             `json:"needs_overworld_rain"`
+	TurnUpsideDown        bool             `json:"turn_upside_down"`
+}
+
+func (c *Client) GetEvolutionChain(ctx context.Context, id int) (EvolutionChainResponse, error) {
+	return getResource[EvolutionChainResponse](ctx, c, "evolution-chain", strconv.Itoa(id))
+}
@@ -0,0 +1,16 @@
+package pokeapi
+
+import "context"
+
+type GenerationResponse struct {
+	MainRegion     NamedAPIResource   `json:"main_region"`
+	PokemonSpecies []NamedAPIResource `json:"pokemon_species"`
+	VersionGroups  []NamedAPIResource `json:"version_groups"`
+	Names          []Name             `json:"names"`
+	Name           string             `json:"name"`
+	ID             int                `json:"id"`
+}
+
+func (c *Client) GetGeneration(ctx context.Context, generationName string) (GenerationResponse, error) {
+	return getResource[GenerationResponse](ctx, c, "generation", generationName)
+}
@@ -0,0 +1,105 @@
+package pokeapi
+
+import (
+	"context"
+	"math/rand"
+)
+
+const (
+	MaxIV = 31
+	// ShinyOdds is the one-in-N chance of a wild Pokémon being shiny, as in
+	// the games since generation VI.
+	ShinyOdds = 4096
+)
+
+const (
+	GenderMale       = "male"
+	GenderFemale     = "female"
+	GenderGenderless = "genderless"
+)
+
+type NatureResponse struct {
+	IncreasedStat NamedAPIResource `json:"increased_stat"`
+	DecreasedStat NamedAPIResource `json:"decreased_stat"`
+	LikesFlavor   NamedAPIResource `json:"likes_flavor"`
+	HatesFlavor   NamedAPIResource `json:"hates_flavor"`
+	Name          string           `json:"name"`
+	ID            int              `json:"id"`
+}
+
+func (r *NatureResponse) ToNature() Nature {
+	return Nature{
+		Name:          r.Name,
+		IncreasedStat: r.IncreasedStat.Name,
+		DecreasedStat: r.DecreasedStat.Name,
+	}
+}
+
+func (c *Client) GetNature(ctx context.Context, natureName string) (NatureResponse, error) {
+	return getResource[NatureResponse](ctx, c, "nature", natureName)
+}
+
+// Nature raises one stat by 10% and lowers another by 10%. Neutral natures
+// leave both empty.
+type Nature struct {
+	Name          string `json:"name"`
+	IncreasedStat string `json:"increased_stat,omitempty"`
+	DecreasedStat string `json:"decreased_stat,omitempty"`
+}
+
+func (n Nature) modifier(stat string) float64 {
+	if n.IncreasedStat == n.DecreasedStat {
+		return 1
+	}
+	switch stat {
+	case n.IncreasedStat:
+		return 1.1
+	case n.DecreasedStat:
+		return 0.9
+	}
+	return 1
+}
+
+func RollLevel(minLevel, maxLevel int) int {
+	if maxLevel <= minLevel {
+		return minLevel
+	}
+	return minLevel + rand.Intn(maxLevel-minLevel+1)
+}
+
+// RollIVs gives every stat a random individual value from 0 to MaxIV.
+func RollIVs(stats []PokemonStat) map[string]int {
+	ivs := make(map[string]int)
+	for _, stat := range stats {
+		ivs[stat.Stat.Name] = rand.Intn(MaxIV + 1)
+	}
+
+	return ivs
+}
+
+// RollGender uses the species gender_rate: the chance of being female in
+// eighths, or -1 for genderless species.
+func RollGender(genderRate int) string {
+	if genderRate < 0 {
+		return GenderGenderless
+	}
+	if rand.Intn(8) < genderRate {
+		return GenderFemale
+	}
+	return GenderMale
+}
+
+func RollShiny() bool {
+	return rand.Intn(ShinyOdds) == 0
+}
+
+// CalcStat computes a stat the way the games do since generation III,
+// assuming no effort values have been earned.
+func CalcStat(stat string, base, iv, level int, nature Nature) int {
+	value := (2*base + iv) * level / 100
+	if stat == "hp" {
+		return value + level + 10
+	}
+
+	return int(float64(value+5) * nature.modifier(stat))
+}
@@ -0,0 +1,56 @@
+package pokeapi
+
+import "testing"
+
+func TestCalcStat(t *testing.T) {
+	adamant := Nature{Name: "adamant", IncreasedStat: "attack", DecreasedStat: "special-attack"}
+	hardy := Nature{Name: "hardy", IncreasedStat: "attack", DecreasedStat: "attack"}
+	cases := []struct {
+		stat     string
+		base     int
+		iv       int
+		level    int
+		nature   Nature
+		expected int
+	}{
+		// Garchomp at level 78 without effort values
+		{stat: "hp", base: 108, iv: 24, level: 78, nature: adamant, expected: 275},
+		{stat: "attack", base: 130, iv: 12, level: 78, nature: adamant, expected: 238},
+		{stat: "special-attack", base: 80, iv: 16, level: 78, nature: adamant, expected: 127},
+		{stat: "defense", base: 95, iv: 30, level: 78, nature: adamant, expected: 176},
+		{stat: "attack", base: 130, iv: 12, level: 78, nature: hardy, expected: 217},
+		{stat: "hp", base: 35, iv: 0, level: 5, nature: hardy, expected: 18},
+	}
+
+	for _, c := range cases {
+		actual := CalcStat(c.stat, c.base, c.iv, c.level, c.nature)
+		if actual != c.expected {
+			t.Errorf("CalcStat(%s, base %d, iv %d, lv %d, %s): expected %d, got %d", c.stat, c.base, c.iv, c.level, c.nature.Name, c.expected, actual)
+		}
+	}
+}
+
+func TestRolls(t *testing.T) {
+	for range 200 {
+		if level := RollLevel(3, 5); level < 3 || level > 5 {
+			t.Fatalf("level %d outside 3-5", level)
+		}
+		for stat, iv := range RollIVs([]PokemonStat{{Stat: NamedAPIResource{Name: "hp"}}}) {
+			if stat != "hp" || iv < 0 || iv > MaxIV {
+				t.Fatalf("unexpected IV %s: %d", stat, iv)
+			}
+		}
+		if gender := RollGender(-1); gender != GenderGenderless {
+			t.Fatalf("expected genderless, got %s", gender)
+		}
+		if gender := RollGender(0); gender != GenderMale {
+			t.Fatalf("expected male-only species to be male, got %s", gender)
+		}
+		if gender := RollGender(8); gender != GenderFemale {
+			t.Fatalf("expected female-only species to be female, got %s", gender)
+		}
+	}
+	if level := RollLevel(7, 7); level != 7 {
+		t.Errorf("expected level 7, got %d", level)
+	}
+}
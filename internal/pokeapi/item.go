@@ -0,0 +1,64 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+type ItemHolderPokemon struct {
+	Pokemon        NamedAPIResource         `json:"pokemon"`
+	VersionDetails []PokemonHeldItemVersion `json:"version_details"`
+}
+
+type ItemResponse struct {
+	Category      NamedAPIResource    `json:"category"`
+	FlingEffect   NamedAPIResource    `json:"fling_effect"`
+	Attributes    []NamedAPIResource  `json:"attributes"`
+	EffectEntries []VerboseEffect     `json:"effect_entries"`
+	HeldByPokemon []ItemHolderPokemon `json:"held_by_pokemon"`
+	FlingPower    *int                `json:"fling_power"`
+	Name          string              `json:"name"`
+	ID            int                 `json:"id"`
+	Cost          int                 `json:"cost"`
+}
+
+// Effect returns the short effect text in the given language.
+func (r *ItemResponse) Effect(language string) string {
+	for _, entry := range r.EffectEntries {
+		if entry.Language.Name == language {
+			return strings.Join(strings.Fields(entry.ShortEffect), " ")
+		}
+	}
+
+	return ""
+}
+
+type BerryFlavorMap struct {
+	Flavor  NamedAPIResource `json:"flavor"`
+	Potency int              `json:"potency"`
+}
+
+type BerryResponse struct {
+	Firmness         NamedAPIResource `json:"firmness"`
+	Item             NamedAPIResource `json:"item"`
+	NaturalGiftType  NamedAPIResource `json:"natural_gift_type"`
+	Flavors          []BerryFlavorMap `json:"flavors"`
+	Name             string           `json:"name"`
+	ID               int              `json:"id"`
+	GrowthTime       int              `json:"growth_time"`
+	MaxHarvest       int              `json:"max_harvest"`
+	NaturalGiftPower int              `json:"natural_gift_power"`
+	Size             int              `json:"size"`
+	Smoothness       int              `json:"smoothness"`
+	SoilDryness      int              `json:"soil_dryness"`
+}
+
+func (c *Client) GetItem(ctx context.Context, itemName string) (ItemResponse, error) {
+	return getResource[ItemResponse](ctx, c, "item", itemName)
+}
+
+// GetBerry takes the berry name without the "-berry" suffix its item has,
+// e.g. "oran" for the "oran-berry" item.
+func (c *Client) GetBerry(ctx context.Context, berryName string) (BerryResponse, error) {
+	return getResource[BerryResponse](ctx, c, "berry", berryName)
+}
@@ -0,0 +1,155 @@
+package pokeapi
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type MoveFlavorText struct {
+	Language     NamedAPIResource `json:"language"`
+	VersionGroup NamedAPIResource `json:"version_group"`
+	FlavorText   string           `json:"flavor_text"`
+}
+
+// PastMoveStatValues holds the values a move had before VersionGroup. Nil
+// and empty fields did not change.
+type PastMoveStatValues struct {
+	Type          *NamedAPIResource `json:"type"`
+	VersionGroup  NamedAPIResource  `json:"version_group"`
+	EffectEntries []VerboseEffect   `json:"effect_entries"`
+	Power         *int              `json:"power"`
+	Accuracy      *int              `json:"accuracy"`
+	PP            *int              `json:"pp"`
+	EffectChance  *int              `json:"effect_chance"`
+}
+
+type MoveResponse struct {
+	Type              NamedAPIResource     `json:"type"`
+	DamageClass       NamedAPIResource     `json:"damage_class"`
+	Target            NamedAPIResource     `json:"target"`
+	Generation        NamedAPIResource     `json:"generation"`
+	EffectEntries     []VerboseEffect      `json:"effect_entries"`
+	FlavorTextEntries []MoveFlavorText     `json:"flavor_text_entries"`
+	PastValues        []PastMoveStatValues `json:"past_values"`
+	Power             *int                 `json:"power"`
+	Accuracy          *int                 `json:"accuracy"`
+	PP                *int                 `json:"pp"`
+	EffectChance      *int                 `json:"effect_chance"`
+	Name              string               `json:"name"`
+	ID                int                  `json:"id"`
+	Priority          int                  `json:"priority"`
+}
+
+// Effect returns the short effect text in the given language with the
+// "$effect_chance" placeholder filled in.
+func (r *MoveResponse) Effect(language string) string {
+	for _, entry := range r.EffectEntries {
+		if entry.Language.Name != language {
+			continue
+		}
+
+		effect := entry.ShortEffect
+		if r.EffectChance != nil {
+			effect = strings.ReplaceAll(effect, "$effect_chance", strconv.Itoa(*r.EffectChance))
+		}
+		return strings.Join(strings.Fields(effect), " ")
+	}
+
+	return ""
+}
+
+// InVersionGroup returns the move as it was in the version group with the
+// given order. orders maps the version groups named in r.PastValues to their
+// order.
+func (r MoveResponse) InVersionGroup(order int, orders map[string]int) MoveResponse {
+	past := slices.Clone(r.PastValues)
+	sort.Slice(past, func(i, j int) bool {
+		return orders[past[i].VersionGroup.Name] > orders[past[j].VersionGroup.Name]
+	})
+
+	// Newest first, so the values of the earliest change after order win
+	move := r
+	for _, values := range past {
+		if orders[values.VersionGroup.Name] <= order {
+			continue
+		}
+		if values.Type != nil {
+			move.Type = *values.Type
+		}
+		if len(values.EffectEntries) > 0 {
+			move.EffectEntries = values.EffectEntries
+		}
+		if values.Power != nil {
+			move.Power = values.Power
+		}
+		if values.Accuracy != nil {
+			move.Accuracy = values.Accuracy
+		}
+		if values.PP != nil {
+			move.PP = values.PP
+		}
+		if values.EffectChance != nil {
+			move.EffectChance = values.EffectChance
+		}
+	}
+
+	return move
+}
+
+func (c *Client) GetMove(ctx context.Context, moveName string) (MoveResponse, error) {
+	return getResource[MoveResponse](ctx, c, "move", moveName)
+}
+
+type LearnedMove struct {
+	Move  string
+	Level int
+}
+
+// Learnset groups the moves learnable in versionGroup by learn method, each
+// group sorted by level and then name.
+func Learnset(moves []PokemonMove, versionGroup string) map[string][]LearnedMove {
+	learnset := make(map[string][]LearnedMove)
+	for _, move := range moves {
+		for _, detail := range move.VersionGroupDetails {
+			if detail.VersionGroup.Name != versionGroup {
+				continue
+			}
+			method := detail.MoveLearnMethod.Name
+			learnset[method] = append(learnset[method], LearnedMove{
+				Move:  move.MoveInfo.Name,
+				Level: detail.LevelLearnedAt,
+			})
+		}
+	}
+
+	for _, learned := range learnset {
+		sort.Slice(learned, func(i, j int) bool {
+			if learned[i].Level != learned[j].Level {
+				return learned[i].Level < learned[j].Level
+			}
+			return learned[i].Move < learned[j].Move
+		})
+	}
+
+	return learnset
+}
+
+// MoveVersionGroups lists the version groups moves can be learned in, in the
+// order PokeAPI reports them, which is oldest first.
+func MoveVersionGroups(moves []PokemonMove) []string {
+	seen := make(map[string]bool)
+	groups := []string{}
+	for _, move := range moves {
+		for _, detail := range move.VersionGroupDetails {
+			if !seen[detail.VersionGroup.Name] {
+				seen[detail.VersionGroup.Name] = true
+				groups = append(groups, detail.VersionGroup.Name)
+			}
+		}
+	}
+
+	return groups
+}
@@ -0,0 +1,111 @@
+package pokeapi
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestLearnset(t *testing.T) {
+	learnedAt := func(method, versionGroup string, level int) PokemonMoveVersion {
+		return PokemonMoveVersion{
+			MoveLearnMethod: NamedAPIResource{Name: method},
+			VersionGroup:    NamedAPIResource{Name: versionGroup},
+			LevelLearnedAt:  level,
+		}
+	}
+	moves := []PokemonMove{
+		{
+			MoveInfo: NamedAPIResource{Name: "thunderbolt"},
+			VersionGroupDetails: []PokemonMoveVersion{
+				learnedAt("machine", "red-blue", 0),
+				learnedAt("level-up", "sword-shield", 36),
+			},
+		},
+		{
+			MoveInfo: NamedAPIResource{Name: "thunder-shock"},
+			VersionGroupDetails: []PokemonMoveVersion{
+				learnedAt("level-up", "red-blue", 1),
+				learnedAt("level-up", "sword-shield", 1),
+			},
+		},
+		{
+			MoveInfo: NamedAPIResource{Name: "growl"},
+			VersionGroupDetails: []PokemonMoveVersion{
+				learnedAt("level-up", "sword-shield", 1),
+			},
+		},
+	}
+
+	expected := map[string][]LearnedMove{
+		"level-up": {
+			{Move: "growl", Level: 1},
+			{Move: "thunder-shock", Level: 1},
+			{Move: "thunderbolt", Level: 36},
+		},
+	}
+	if actual := Learnset(moves, "sword-shield"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	expected = map[string][]LearnedMove{
+		"level-up": {{Move: "thunder-shock", Level: 1}},
+		"machine":  {{Move: "thunderbolt", Level: 0}},
+	}
+	if actual := Learnset(moves, "red-blue"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if groups := MoveVersionGroups(moves); !slices.Equal(groups, []string{"red-blue", "sword-shield"}) {
+		t.Errorf("unexpected version groups: %v", groups)
+	}
+}
+
+func TestMoveEffect(t *testing.T) {
+	chance := 10
+	move := MoveResponse{
+		EffectEntries: []VerboseEffect{
+			{Language: NamedAPIResource{Name: "en"}, ShortEffect: "Has a $effect_chance% chance to\nparalyze the target."},
+		},
+		EffectChance: &chance,
+	}
+
+	if actual := move.Effect("en"); actual != "Has a 10% chance to paralyze the target." {
+		t.Errorf("unexpected effect: '%s'", actual)
+	}
+}
+
+func TestMoveInVersionGroup(t *testing.T) {
+	power := func(p int) *int { return &p }
+	tackle := MoveResponse{
+		Name:     "tackle",
+		Power:    power(40),
+		Accuracy: power(100),
+		PastValues: []PastMoveStatValues{
+			{VersionGroup: NamedAPIResource{Name: "black-white"}, Power: power(35), Accuracy: power(95)},
+			{VersionGroup: NamedAPIResource{Name: "sun-moon"}, Power: power(50)},
+		},
+	}
+	orders := map[string]int{"black-white": 11, "sun-moon": 17}
+
+	cases := []struct {
+		order    int
+		power    int
+		accuracy int
+	}{
+		{order: 1, power: 35, accuracy: 95},
+		{order: 11, power: 50, accuracy: 100},
+		{order: 15, power: 50, accuracy: 100},
+		{order: 17, power: 40, accuracy: 100},
+	}
+
+	for _, c := range cases {
+		move := tackle.InVersionGroup(c.order, orders)
+		if *move.Power != c.power || *move.Accuracy != c.accuracy {
+			t.Errorf("order %d: expected power %d and accuracy %d, got %d and %d", c.order, c.power, c.accuracy, *move.Power, *move.Accuracy)
+		}
+	}
+	if *tackle.Power != 40 {
+		t.Errorf("expected original move to be unchanged, got power %d", *tackle.Power)
+	}
+}
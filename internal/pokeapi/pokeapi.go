@@ -1,10 +1,19 @@
 package pokeapi
 
-import "time"
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
 
 const (
-	baseURL       = "https://pokeapi.co/api/v2"
-	cacheInterval = 1 * time.Minute
+	DefaultBaseURL = "https://pokeapi.co/api/v2"
+	cacheInterval  = 1 * time.Minute
+	// Enough for a long session of browsing without unbounded growth
+	cacheMaxEntries = 512
+	cacheMaxBytes   = 32 << 20
 )
 
 type NamedAPIResource struct {
@@ -12,7 +21,64 @@ type NamedAPIResource struct {
 	URL  string `json:"url"`
 }
 
+type NamedAPIResourceList struct {
+	Results  []NamedAPIResource `json:"results"`
+	Next     string             `json:"next"`
+	Previous string             `json:"previous"`
+	Count    int                `json:"count"`
+}
+
+type VerboseEffect struct {
+	Language    NamedAPIResource `json:"language"`
+	Effect      string           `json:"effect"`
+	ShortEffect string           `json:"short_effect"`
+}
+
 type Name struct {
 	Language NamedAPIResource `json:"language"`
 	Name     string           `json:"name"`
 }
+
+// ResourceID extracts the numeric ID from a resource URL such as
+// "https://pokeapi.co/api/v2/evolution-chain/10/".
+func ResourceID(resourceURL string) (int, error) {
+	id, err := strconv.Atoi(path.Base(strings.TrimRight(resourceURL, "/")))
+	if err != nil {
+		return 0, fmt.Errorf("no ID in resource URL '%s'", resourceURL)
+	}
+
+	return id, nil
+}
+
+// ExistsIn reports whether something introduced in the given generation
+// exists in the generation with ID generation. Zero means all generations.
+func ExistsIn(introduced NamedAPIResource, generation int) bool {
+	if generation == 0 {
+		return true
+	}
+
+	id, err := ResourceID(introduced.URL)
+	return err != nil || id <= generation
+}
+
+// pastIndex picks the entry in effect in the generation with ID generation
+// from past data, each entry tagged with the last generation it applied to.
+// It returns -1 when the current data applies.
+func pastIndex(lastGenerations []NamedAPIResource, generation int) int {
+	if generation == 0 {
+		return -1
+	}
+
+	index, closest := -1, 0
+	for i, last := range lastGenerations {
+		id, err := ResourceID(last.URL)
+		if err != nil || id < generation {
+			continue
+		}
+		if index == -1 || id < closest {
+			index, closest = i, id
+		}
+	}
+
+	return index
+}
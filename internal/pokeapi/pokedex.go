@@ -2,63 +2,233 @@ package pokeapi
 
 import (
 	"errors"
+	"fmt"
 	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-var ErrAddDuplicatePokemon = errors.New("pokemon already present in pokedex")
+// MaxNicknameLength is the longest nickname, in characters, a Pokémon can get.
+const MaxNicknameLength = 12
+
+var ErrAddDuplicatePokemon = errors.New("a pokemon with this ID is already in the pokedex")
 var ErrGetAbsentPokemon = errors.New("pokemon is not in pokedex")
+var ErrInvalidNickname = errors.New("invalid nickname")
+var ErrNicknameTaken = errors.New("nickname is already taken")
 
+// Pokedex stores every caught Pokémon under its own ID, so several of the
+// same species can be owned, and indexes them by species. It also records
+// every Pokémon the player has seen, caught or not.
 type Pokedex struct {
-	caughtPokemon map[string]Pokemon
+	caughtPokemon map[int]Pokemon
+	species       map[string][]int
+	nicknames     map[string]int
+	seen          map[string]bool
+	nextID        int
 }
 
 func NewPokedex() Pokedex {
 	return Pokedex{
-		caughtPokemon: make(map[string]Pokemon),
+		caughtPokemon: make(map[int]Pokemon),
+		species:       make(map[string][]int),
+		nicknames:     make(map[string]int),
+		seen:          make(map[string]bool),
+		nextID:        1,
 	}
 }
 
-func (p *Pokedex) AddPokemon(pokemon Pokemon) error {
-	_, ok := p.caughtPokemon[pokemon.Name]
-	if ok {
+// AddPokemon stores a newly caught Pokémon, assigning it the next free ID.
+func (p *Pokedex) AddPokemon(pokemon Pokemon) Pokemon {
+	pokemon.ID = p.nextID
+	// Cannot collide: nextID is always above every stored ID
+	_ = p.insert(pokemon)
+
+	return pokemon
+}
+
+// insert stores pokemon under its existing ID.
+func (p *Pokedex) insert(pokemon Pokemon) error {
+	if _, ok := p.caughtPokemon[pokemon.ID]; ok {
 		return ErrAddDuplicatePokemon
 	}
+	if pokemon.Nickname != "" {
+		if err := p.checkNickname(pokemon.Nickname, pokemon.ID); err != nil {
+			return err
+		}
+		p.nicknames[pokemon.Nickname] = pokemon.ID
+	}
+
+	p.caughtPokemon[pokemon.ID] = pokemon
+	p.species[pokemon.Name] = append(p.species[pokemon.Name], pokemon.ID)
+	p.seen[pokemon.Name] = true
+	p.nextID = max(p.nextID, pokemon.ID+1)
+
+	return nil
+}
+
+func (p *Pokedex) GetPokemon(id int) (Pokemon, error) {
+	pokemon, ok := p.caughtPokemon[id]
+	if !ok {
+		return Pokemon{}, ErrGetAbsentPokemon
+	}
+
+	return pokemon, nil
+}
+
+// FindSpecies returns every caught Pokémon of a species, oldest first.
+func (p *Pokedex) FindSpecies(name string) []Pokemon {
+	found := []Pokemon{}
+	for _, id := range p.species[name] {
+		found = append(found, p.caughtPokemon[id])
+	}
+
+	return found
+}
+
+// FindNickname returns the caught Pokémon with the given nickname.
+func (p *Pokedex) FindNickname(nickname string) (Pokemon, bool) {
+	id, ok := p.nicknames[nickname]
+	if !ok {
+		return Pokemon{}, false
+	}
+
+	return p.caughtPokemon[id], true
+}
+
+// SetNickname names a caught Pokémon, replacing any previous nickname.
+// Nicknames must be unique, at most MaxNicknameLength characters and not
+// look like an ID.
+func (p *Pokedex) SetNickname(id int, nickname string) (Pokemon, error) {
+	pokemon, ok := p.caughtPokemon[id]
+	if !ok {
+		return Pokemon{}, ErrGetAbsentPokemon
+	}
+	if err := p.checkNickname(nickname, id); err != nil {
+		return Pokemon{}, err
+	}
 
-	p.caughtPokemon[pokemon.Name] = pokemon
+	delete(p.nicknames, pokemon.Nickname)
+	pokemon.Nickname = nickname
+	p.nicknames[nickname] = id
+	p.caughtPokemon[id] = pokemon
+
+	return pokemon, nil
+}
+
+// checkNickname validates nickname for the Pokémon with the given ID.
+func (p *Pokedex) checkNickname(nickname string, id int) error {
+	if nickname == "" || strings.ContainsFunc(nickname, unicode.IsSpace) {
+		return fmt.Errorf("%w: must be a single word", ErrInvalidNickname)
+	}
+	if length := utf8.RuneCountInString(nickname); length > MaxNicknameLength {
+		return fmt.Errorf("%w: '%s' is %d characters, the limit is %d", ErrInvalidNickname, nickname, length, MaxNicknameLength)
+	}
+	if _, err := strconv.Atoi(nickname); err == nil {
+		return fmt.Errorf("%w: '%s' would be mistaken for an ID", ErrInvalidNickname, nickname)
+	}
+	if owner, ok := p.nicknames[nickname]; ok && owner != id {
+		return fmt.Errorf("%w: '%s' belongs to #%d", ErrNicknameTaken, nickname, owner)
+	}
 
 	return nil
 }
 
-func (p *Pokedex) GetPokemon(name string) (Pokemon, error) {
-	pokemon, ok := p.caughtPokemon[name]
+// HasCaught reports whether at least one Pokémon of the species is owned.
+func (p *Pokedex) HasCaught(name string) bool {
+	return len(p.species[name]) > 0
+}
+
+// CaughtNames returns the sorted names of all Pokémon currently owned.
+func (p *Pokedex) CaughtNames() []string {
+	names := []string{}
+	for name := range p.species {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// MarkSeen records that the player has come across a Pokémon and reports
+// whether it had not been seen before. Caught Pokémon are seen too and stay
+// seen when released.
+func (p *Pokedex) MarkSeen(name string) bool {
+	if p.seen[name] {
+		return false
+	}
+
+	p.seen[name] = true
+	return true
+}
+
+func (p *Pokedex) HasSeen(name string) bool {
+	return p.seen[name]
+}
+
+// SeenNames returns the sorted names of all Pokémon ever seen.
+func (p *Pokedex) SeenNames() []string {
+	names := []string{}
+	for name := range p.seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (p *Pokedex) RemovePokemon(id int) (Pokemon, error) {
+	pokemon, ok := p.caughtPokemon[id]
 	if !ok {
 		return Pokemon{}, ErrGetAbsentPokemon
 	}
 
+	delete(p.caughtPokemon, id)
+	delete(p.nicknames, pokemon.Nickname)
+	ids := p.species[pokemon.Name]
+	for i, speciesID := range ids {
+		if speciesID == id {
+			ids = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	if len(ids) == 0 {
+		delete(p.species, pokemon.Name)
+	} else {
+		p.species[pokemon.Name] = ids
+	}
+
 	return pokemon, nil
 }
 
+// GetAllPokemon returns every caught Pokémon ordered by ID.
 func (p *Pokedex) GetAllPokemon() []Pokemon {
 	allPokemon := []Pokemon{}
 	for _, p := range p.caughtPokemon {
 		allPokemon = append(allPokemon, p)
 	}
+	sort.Slice(allPokemon, func(i, j int) bool {
+		return allPokemon[i].ID < allPokemon[j].ID
+	})
 
 	return allPokemon
 }
 
-func AttemptCatchPokemon(baseExp, maxBaseExp int, minChance, maxChance float64) bool {
-	chance := catchChance(baseExp, maxBaseExp, minChance, maxChance)
+// MaxCaptureRate is the highest capture_rate a species can have.
+const MaxCaptureRate = 255
+
+// AttemptCatchPokemon rolls a catch using the species capture rate. The odds
+// match a Poké Ball thrown at a weakened Pokémon, roughly capture_rate/255,
+// clamped to [minChance, maxChance].
+func AttemptCatchPokemon(captureRate int, minChance, maxChance float64) bool {
+	chance := catchChance(captureRate, minChance, maxChance)
 	return rand.Float64() <= chance
 }
 
-func catchChance(baseExp, maxBaseExp int, minChance, maxChance float64) float64 {
-	if baseExp > maxBaseExp {
-		baseExp = maxBaseExp
-	}
-
-	ratio := float64(baseExp) / float64(maxBaseExp)
-	chance := 1.0 - ratio
+func catchChance(captureRate int, minChance, maxChance float64) float64 {
+	chance := float64(captureRate) / MaxCaptureRate
 
 	if chance < minChance {
 		return minChance
@@ -0,0 +1,123 @@
+package pokeapi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCatchChance(t *testing.T) {
+	cases := []struct {
+		captureRate int
+		expected    float64
+	}{
+		{captureRate: 3, expected: 0.05},
+		{captureRate: 45, expected: 45.0 / 255},
+		{captureRate: 190, expected: 190.0 / 255},
+		{captureRate: 255, expected: 0.95},
+	}
+
+	for _, c := range cases {
+		actual := catchChance(c.captureRate, 0.05, 0.95)
+		if actual != c.expected {
+			t.Errorf("catchChance(%d): expected %v, got %v", c.captureRate, c.expected, actual)
+		}
+	}
+}
+
+func TestPokedexInstances(t *testing.T) {
+	pokedex := NewPokedex()
+	first := pokedex.AddPokemon(Pokemon{Name: "pikachu"})
+	pokedex.AddPokemon(Pokemon{Name: "eevee"})
+	third := pokedex.AddPokemon(Pokemon{Name: "pikachu"})
+
+	if first.ID != 1 || third.ID != 3 {
+		t.Errorf("expected IDs 1 and 3, got %d and %d", first.ID, third.ID)
+	}
+	if found := pokedex.FindSpecies("pikachu"); len(found) != 2 {
+		t.Errorf("expected 2 pikachu, got %d", len(found))
+	}
+
+	if _, err := pokedex.RemovePokemon(first.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := pokedex.GetPokemon(first.ID); err != ErrGetAbsentPokemon {
+		t.Errorf("expected ErrGetAbsentPokemon, got %v", err)
+	}
+	if !pokedex.HasCaught("pikachu") {
+		t.Error("expected pikachu to still be caught")
+	}
+
+	if _, err := pokedex.RemovePokemon(third.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokedex.HasCaught("pikachu") {
+		t.Error("expected no pikachu after releasing both")
+	}
+	if added := pokedex.AddPokemon(Pokemon{Name: "pikachu"}); added.ID != 4 {
+		t.Errorf("expected released IDs not to be reused, got %d", added.ID)
+	}
+}
+
+func TestSetNickname(t *testing.T) {
+	pokedex := NewPokedex()
+	pikachu := pokedex.AddPokemon(Pokemon{Name: "pikachu"})
+	eevee := pokedex.AddPokemon(Pokemon{Name: "eevee"})
+
+	if _, err := pokedex.SetNickname(pikachu.ID, "sparky"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		id       int
+		nickname string
+		expected error
+	}{
+		{id: eevee.ID, nickname: "sparky", expected: ErrNicknameTaken},
+		{id: eevee.ID, nickname: "averyverylongname", expected: ErrInvalidNickname},
+		{id: eevee.ID, nickname: "42", expected: ErrInvalidNickname},
+		{id: eevee.ID, nickname: "", expected: ErrInvalidNickname},
+		{id: 99, nickname: "ghost", expected: ErrGetAbsentPokemon},
+		{id: eevee.ID, nickname: "évoli", expected: nil},
+		{id: pikachu.ID, nickname: "sparky", expected: nil},
+	}
+
+	for _, c := range cases {
+		_, err := pokedex.SetNickname(c.id, c.nickname)
+		if !errors.Is(err, c.expected) {
+			t.Errorf("SetNickname(%d, %q): expected error %v, got %v", c.id, c.nickname, c.expected, err)
+		}
+	}
+
+	// Renaming frees the old nickname
+	if _, err := pokedex.SetNickname(pikachu.ID, "volt"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := pokedex.FindNickname("sparky"); ok {
+		t.Error("expected old nickname to be freed")
+	}
+	if found, ok := pokedex.FindNickname("volt"); !ok || found.ID != pikachu.ID {
+		t.Errorf("expected volt to be #%d, got %v", pikachu.ID, found)
+	}
+
+	if _, err := pokedex.RemovePokemon(pikachu.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := pokedex.FindNickname("volt"); ok {
+		t.Error("expected nickname of released pokemon to be freed")
+	}
+}
+
+func TestMarkSeen(t *testing.T) {
+	pokedex := NewPokedex()
+	pokedex.AddPokemon(Pokemon{Name: "pikachu"})
+
+	if !pokedex.MarkSeen("rattata") {
+		t.Error("expected rattata to be newly seen")
+	}
+	if pokedex.MarkSeen("rattata") || pokedex.MarkSeen("pikachu") {
+		t.Error("expected already seen pokemon not to be reported as new")
+	}
+	if !pokedex.HasSeen("rattata") || pokedex.HasSeen("eevee") {
+		t.Errorf("unexpected seen pokemon: %v", pokedex.SeenNames())
+	}
+}
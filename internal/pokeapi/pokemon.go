@@ -1,19 +1,24 @@
 package pokeapi
 
-import (
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
-	"net/http"
-)
+import "context"
 
+// Pokemon is a caught Pokémon. Only what inspect shows without a connection
+// is stored; everything else, such as its moves, is the same for all Pokémon
+// of a kind and is fetched with GetPokemonDetails when needed.
 type Pokemon struct {
-	Types  []PokemonType
-	Stats  []PokemonStat
-	Name   string `json:"name"`
-	Height int    `json:"height"`
-	Weight int    `json:"weight"`
+	IVs      map[string]int `json:"ivs,omitempty"`
+	Nature   Nature         `json:"nature"`
+	Types    []PokemonType  `json:"types"`
+	Stats    []PokemonStat  `json:"stats"`
+	Name     string         `json:"name"`
+	Gender   string         `json:"gender,omitempty"`
+	Nickname string         `json:"nickname,omitempty"`
+	ID       int            `json:"id"`
+	Height   int            `json:"height"`
+	Weight   int            `json:"weight"`
+	// Level is zero for Pokémon caught before levels were tracked
+	Level int  `json:"level,omitempty"`
+	Shiny bool `json:"shiny,omitempty"`
 }
 
 type PokemonType struct {
@@ -108,51 +113,26 @@ func (r *PokemonDetailsResponse) ToPokemon() Pokemon {
 	}
 }
 
-func (c *Client) GetPokemonDetails(pokemonName string) (PokemonDetailsResponse, error) {
-	if pokemonName == "" {
-		return PokemonDetailsResponse{}, errors.New("no pokemon name provided")
+// TypesIn returns the Pokémon's types in the generation with ID generation;
+// zero means the current types.
+func (r *PokemonDetailsResponse) TypesIn(generation int) []PokemonType {
+	lastGenerations := []NamedAPIResource{}
+	for _, past := range r.PastTypes {
+		lastGenerations = append(lastGenerations, past.Generation)
 	}
-
-	url := baseURL + "/pokemon/" + pokemonName
-	var pokemonResponse PokemonDetailsResponse
-
-	if data, ok := c.cache.Get(url); ok {
-		err := json.Unmarshal(data, &pokemonResponse)
-		if err != nil {
-			return PokemonDetailsResponse{}, fmt.Errorf("error during Unmarshal: %w", err)
-		}
-
-		return pokemonResponse, nil
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return PokemonDetailsResponse{}, fmt.Errorf("error creating request: %w", err)
-	}
-
-	res, err := c.httpClient.Do(req)
-	if err != nil {
-		return PokemonDetailsResponse{}, fmt.Errorf("network error: %w", err)
+	if i := pastIndex(lastGenerations, generation); i >= 0 {
+		return r.PastTypes[i].Types
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode == 404 {
-		return PokemonDetailsResponse{}, fmt.Errorf("unknown pokemon: '%s'", pokemonName)
-	}
-	if res.StatusCode > 299 {
-		return PokemonDetailsResponse{}, fmt.Errorf("response failed with status code: %d", res.StatusCode)
-	}
-
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return PokemonDetailsResponse{}, fmt.Errorf("error reading response: %w", err)
-	}
-	c.cache.Add(url, data)
+	return r.Types
+}
 
-	err = json.Unmarshal(data, &pokemonResponse)
-	if err != nil {
-		return PokemonDetailsResponse{}, fmt.Errorf("error during Unmarshal: %w", err)
-	}
+// ActualStat computes the Pokémon's value of stat from its base stat, IV,
+// level and nature.
+func (p *Pokemon) ActualStat(stat PokemonStat) int {
+	return CalcStat(stat.Stat.Name, stat.BaseStat, p.IVs[stat.Stat.Name], p.Level, p.Nature)
+}
 
-	return pokemonResponse, nil
+func (c *Client) GetPokemonDetails(ctx context.Context, pokemonName string) (PokemonDetailsResponse, error) {
+	return getResource[PokemonDetailsResponse](ctx, c, "pokemon", pokemonName)
 }
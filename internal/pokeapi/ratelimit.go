@@ -0,0 +1,77 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Defaults chosen to stay well within PokeAPI's fair-use policy
+const (
+	DefaultRateLimit = 10.0
+	DefaultRateBurst = 10
+)
+
+// WithRateLimit throttles network requests to rps per second on average,
+// allowing bursts of up to burst requests. A non-positive rps disables it.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		if rps <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// rateLimiter is a token bucket shared by every goroutine using a Client.
+type rateLimiter struct {
+	m      sync.Mutex
+	last   time.Time
+	rate   float64
+	burst  float64
+	tokens float64
+}
+
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		last:   time.Now(),
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// wait blocks until the caller may send a request and reports how long it
+// waited. Callers reserve a token up front, so concurrent waiters are
+// served in order without holding the lock while sleeping.
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	l.m.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.m.Unlock()
+
+	if delay == 0 {
+		return 0, nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		// Give back the unused reservation
+		l.m.Lock()
+		l.tokens++
+		l.m.Unlock()
+		return 0, err
+	}
+
+	return delay, nil
+}
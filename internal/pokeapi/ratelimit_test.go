@@ -0,0 +1,105 @@
+package pokeapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := newRateLimiter(20, 3)
+	ctx := context.Background()
+
+	for i := range 3 {
+		waited, err := limiter.wait(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if waited != 0 {
+			t.Errorf("request %d within burst waited %v", i, waited)
+		}
+	}
+
+	waited, err := limiter.wait(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if waited < 40*time.Millisecond {
+		t.Errorf("expected to wait about 50ms after burst, waited %v", waited)
+	}
+}
+
+func TestRateLimiterConcurrent(t *testing.T) {
+	const (
+		rps        = 100
+		burst      = 5
+		goroutines = 10
+		perWorker  = 3
+	)
+	limiter := newRateLimiter(rps, burst)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perWorker {
+				if _, err := limiter.wait(context.Background()); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 30 requests with a burst of 5 need 25 more tokens at 100/s
+	minElapsed := time.Duration(goroutines*perWorker-burst) * time.Second / rps
+	if elapsed := time.Since(start); elapsed < minElapsed-10*time.Millisecond {
+		t.Errorf("expected at least %v, took %v", minElapsed, elapsed)
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	limiter := newRateLimiter(1, 1)
+	if _, err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if limiter.tokens < -0.01 {
+		t.Errorf("expected cancelled reservation to be returned, tokens at %v", limiter.tokens)
+	}
+}
+
+func TestClientLogsRateLimiterWaits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	client := NewClient(time.Second, WithRateLimit(50, 1), WithLogger(log.New(&out, "", 0)))
+	defer client.Close()
+
+	for _, path := range []string{"/a", "/b"} {
+		if _, err := get[NamedAPIResource](context.Background(), &client, server.URL+path); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if !strings.Contains(out.String(), "rate limiter: waited") {
+		t.Errorf("expected rate limiter wait in debug output, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,31 @@
+package pokeapi
+
+import "context"
+
+type RegionResponse struct {
+	MainGeneration NamedAPIResource   `json:"main_generation"`
+	Locations      []NamedAPIResource `json:"locations"`
+	Pokedexes      []NamedAPIResource `json:"pokedexes"`
+	VersionGroups  []NamedAPIResource `json:"version_groups"`
+	Names          []Name             `json:"names"`
+	Name           string             `json:"name"`
+	ID             int                `json:"id"`
+}
+
+// LocationResponse is a place such as a town or route, made up of one or
+// more location areas.
+type LocationResponse struct {
+	Region NamedAPIResource   `json:"region"`
+	Areas  []NamedAPIResource `json:"areas"`
+	Names  []Name             `json:"names"`
+	Name   string             `json:"name"`
+	ID     int                `json:"id"`
+}
+
+func (c *Client) GetRegion(ctx context.Context, regionName string) (RegionResponse, error) {
+	return getResource[RegionResponse](ctx, c, "region", regionName)
+}
+
+func (c *Client) GetLocation(ctx context.Context, locationName string) (LocationResponse, error) {
+	return getResource[LocationResponse](ctx, c, "location", locationName)
+}
@@ -0,0 +1,145 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// get is the single path every endpoint uses to fetch and decode a PokeAPI
+// document, consulting the cache first. Responses are only cached once they
+// decode successfully.
+func get[T any](ctx context.Context, c *Client, url string) (T, error) {
+	var result T
+
+	if data, ok := c.cacheGet(url); ok {
+		err := json.Unmarshal(data, &result)
+		if err != nil {
+			return result, fmt.Errorf("error during Unmarshal: %w", err)
+		}
+
+		return result, nil
+	}
+
+	data, err := c.do(ctx, url)
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return result, fmt.Errorf("error during Unmarshal: %w", err)
+	}
+	c.cacheAdd(url, data)
+
+	return result, nil
+}
+
+// getResource fetches a named resource such as /pokemon/{name}, reporting a
+// missing resource as a *NotFoundError.
+func getResource[T any](ctx context.Context, c *Client, resource, name string) (T, error) {
+	var result T
+	if name == "" {
+		return result, fmt.Errorf("no %s name provided", resource)
+	}
+
+	result, err := get[T](ctx, c, c.baseURL+"/"+resource+"/"+name)
+	if errors.Is(err, ErrNotFound) {
+		return result, &NotFoundError{Err: err, Resource: resource, Name: name}
+	}
+
+	return result, err
+}
+
+// GetResourceList returns every entry of a paginated resource list such as
+// /pokemon or /location-area in a single request.
+func (c *Client) GetResourceList(ctx context.Context, resource string) ([]NamedAPIResource, error) {
+	list, err := get[NamedAPIResourceList](ctx, c, c.baseURL+"/"+resource+"?limit=100000")
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Results, nil
+}
+
+// do performs a GET request, retrying transient failures according to the
+// client's retry policy, and returns the raw response body.
+func (c *Client) do(ctx context.Context, url string) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			waited, err := c.limiter.wait(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if waited > 0 {
+				c.logf("rate limiter: waited %v", waited.Round(time.Millisecond))
+			}
+		}
+
+		c.logf("GET %s (attempt %d)", url, attempt)
+		data, err := c.doOnce(ctx, url)
+		if err == nil {
+			return data, nil
+		}
+		if !retryable(err) || ctx.Err() != nil {
+			return nil, err
+		}
+		if attempt >= c.retry.MaxAttempts {
+			if attempt == 1 {
+				return nil, err
+			}
+			return nil, &RetryError{Err: err, Attempts: attempt}
+		}
+
+		delay := c.retry.backoff(attempt)
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			if retryAfter, ok := parseRetryAfter(httpErr.retryAfter, time.Now()); ok {
+				if retryAfter > c.retry.MaxDelay {
+					return nil, &RetryError{Err: err, Attempts: attempt}
+				}
+				delay = retryAfter
+			}
+		}
+
+		c.logf("retrying in %v: %v", delay.Round(time.Millisecond), err)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) doOnce(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("%w: %w", errNetwork, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode > 299 {
+		return nil, &HTTPError{
+			URL:        url,
+			retryAfter: res.Header.Get("Retry-After"),
+			StatusCode: res.StatusCode,
+		}
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error reading response: %w", errNetwork, err)
+	}
+
+	return data, nil
+}
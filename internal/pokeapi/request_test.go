@@ -0,0 +1,84 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGet(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		switch r.URL.Path {
+		case "/ok":
+			w.Write([]byte(`{"name": "pikachu", "url": "https://example.com"}`))
+		case "/broken":
+			w.Write([]byte(`{"name": `))
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(time.Second, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	defer client.Close()
+
+	for range 2 {
+		resource, err := get[NamedAPIResource](context.Background(), &client, server.URL+"/ok")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resource.Name != "pikachu" {
+			t.Errorf("expected 'pikachu', got '%s'", resource.Name)
+		}
+	}
+	if hits.Load() != 1 {
+		t.Errorf("expected second get to be served from cache, got %d requests", hits.Load())
+	}
+
+	if _, err := get[NamedAPIResource](context.Background(), &client, server.URL+"/missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := get[NamedAPIResource](context.Background(), &client, server.URL+"/error"); err == nil {
+		t.Errorf("expected error for status 500")
+	}
+
+	for range 2 {
+		if _, err := get[NamedAPIResource](context.Background(), &client, server.URL+"/broken"); err == nil {
+			t.Errorf("expected error for malformed body")
+		}
+	}
+	if _, ok := client.cacheGet(server.URL + "/broken"); ok {
+		t.Errorf("expected malformed body to not be cached")
+	}
+}
+
+func TestGetCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(10 * time.Second)
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := get[NamedAPIResource](ctx, &client, server.URL)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    50 * time.Millisecond,
+}
+
+// flakyServer fails the first failures requests to every path with status,
+// then serves a valid resource.
+func flakyServer(failures int32, status int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+
+	return server, &hits
+}
+
+func TestRetryRecovers(t *testing.T) {
+	cases := []struct {
+		status     int
+		retryAfter string
+	}{
+		{status: http.StatusServiceUnavailable},
+		{status: http.StatusBadGateway},
+		{status: http.StatusTooManyRequests, retryAfter: "0"},
+	}
+
+	for _, c := range cases {
+		server, hits := flakyServer(2, c.status, c.retryAfter)
+		client := NewClient(time.Second, WithRetryPolicy(testRetryPolicy))
+
+		resource, err := get[NamedAPIResource](context.Background(), &client, server.URL)
+		if err != nil {
+			t.Errorf("status %d: unexpected error: %v", c.status, err)
+		} else if resource.Name != "pikachu" {
+			t.Errorf("status %d: expected 'pikachu', got '%s'", c.status, resource.Name)
+		}
+		if hits.Load() != 3 {
+			t.Errorf("status %d: expected 3 requests, got %d", c.status, hits.Load())
+		}
+
+		client.Close()
+		server.Close()
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	server, hits := flakyServer(100, http.StatusInternalServerError, "")
+	defer server.Close()
+
+	client := NewClient(time.Second, WithRetryPolicy(testRetryPolicy))
+	defer client.Close()
+
+	_, err := get[NamedAPIResource](context.Background(), &client, server.URL)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected *RetryError, got %v", err)
+	}
+	if retryErr.Attempts != 3 || hits.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d (%d requests)", retryErr.Attempts, hits.Load())
+	}
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected wrapped *HTTPError with status 500, got %v", err)
+	}
+}
+
+func TestNoRetryOnPermanentFailure(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusNotFound, http.StatusNotImplemented} {
+		server, hits := flakyServer(100, status, "")
+		client := NewClient(time.Second, WithRetryPolicy(testRetryPolicy))
+
+		_, err := get[NamedAPIResource](context.Background(), &client, server.URL)
+		var retryErr *RetryError
+		if err == nil || errors.As(err, &retryErr) {
+			t.Errorf("status %d: expected permanent error, got %v", status, err)
+		}
+		if hits.Load() != 1 {
+			t.Errorf("status %d: expected a single request, got %d", status, hits.Load())
+		}
+
+		client.Close()
+		server.Close()
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	server, hits := flakyServer(100, http.StatusTooManyRequests, "3600")
+	defer server.Close()
+
+	client := NewClient(time.Second, WithRetryPolicy(testRetryPolicy))
+	defer client.Close()
+
+	start := time.Now()
+	_, err := get[NamedAPIResource](context.Background(), &client, server.URL)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected *RetryError, got %v", err)
+	}
+	if hits.Load() != 1 || time.Since(start) > time.Second {
+		t.Errorf("expected to give up without waiting, got %d requests in %v", hits.Load(), time.Since(start))
+	}
+}
+
+func TestRetryNetworkError(t *testing.T) {
+	server, _ := flakyServer(0, 0, "")
+	url := server.URL
+	server.Close()
+
+	client := NewClient(time.Second, WithRetryPolicy(testRetryPolicy))
+	defer client.Close()
+
+	_, err := get[NamedAPIResource](context.Background(), &client, url)
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || !errors.Is(err, errNetwork) {
+		t.Errorf("expected exhausted retries of a network error, got %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		{header: "", ok: false},
+		{header: "garbage", ok: false},
+		{header: "-5", ok: false},
+		{header: "120", expected: 2 * time.Minute, ok: true},
+		{header: "Mon, 01 Jan 2024 12:00:30 GMT", expected: 30 * time.Second, ok: true},
+		{header: "Mon, 01 Jan 2024 11:00:00 GMT", expected: 0, ok: true},
+	}
+
+	for _, c := range cases {
+		actual, ok := parseRetryAfter(c.header, now)
+		if ok != c.ok || actual != c.expected {
+			t.Errorf("parseRetryAfter(%q): expected (%v, %v), got (%v, %v)", c.header, c.expected, c.ok, actual, ok)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt := 1; attempt <= 8; attempt++ {
+		window := min(policy.BaseDelay<<(attempt-1), policy.MaxDelay)
+		for range 20 {
+			delay := policy.backoff(attempt)
+			if delay < window/2 || delay > window {
+				t.Errorf("attempt %d: delay %v outside [%v, %v]", attempt, delay, window/2, window)
+			}
+		}
+	}
+}
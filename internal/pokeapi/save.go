@@ -0,0 +1,109 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SaveVersion is the schema version written by Pokedex.Save.
+// Version 1 predates per-Pokémon IDs and version 2 seen tracking.
+const SaveVersion = 3
+
+var ErrCorruptSave = errors.New("save file is corrupt")
+var ErrSaveVersionTooNew = errors.New("save file was written by a newer version of the Pokédex")
+
+type saveFile struct {
+	Pokemon []Pokemon `json:"pokemon"`
+	Seen    []string  `json:"seen"`
+	Version int       `json:"version"`
+	NextID  int       `json:"next_id"`
+}
+
+// Save writes the Pokédex to path atomically: the data is written to a
+// temporary file in the same directory which then replaces path.
+func (p *Pokedex) Save(path string) error {
+	data, err := json.MarshalIndent(saveFile{
+		Pokemon: p.GetAllPokemon(),
+		Seen:    p.SeenNames(),
+		Version: SaveVersion,
+		NextID:  p.nextID,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error during Marshal: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating save directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".pokedex-*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating temporary save file: %w", err)
+	}
+	// Removing after a successful rename fails harmlessly
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing save file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing save file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing save file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error replacing save file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadPokedex reads a Pokédex previously written by Save. If path does not
+// exist the returned error satisfies errors.Is(err, fs.ErrNotExist).
+func LoadPokedex(path string) (Pokedex, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Pokedex{}, fmt.Errorf("error reading save file: %w", err)
+	}
+
+	var save saveFile
+	if err := json.Unmarshal(data, &save); err != nil {
+		return Pokedex{}, fmt.Errorf("%w: %s: %w", ErrCorruptSave, path, err)
+	}
+	if save.Version > SaveVersion {
+		return Pokedex{}, fmt.Errorf("%w: %s has version %d, this build supports up to %d", ErrSaveVersionTooNew, path, save.Version, SaveVersion)
+	}
+	if save.Version < 1 {
+		return Pokedex{}, fmt.Errorf("%w: %s has invalid version %d", ErrCorruptSave, path, save.Version)
+	}
+
+	pokedex := NewPokedex()
+	for _, pokemon := range save.Pokemon {
+		if save.Version == 1 {
+			pokedex.AddPokemon(pokemon)
+			continue
+		}
+
+		if pokemon.ID < 1 {
+			return Pokedex{}, fmt.Errorf("%w: %s: pokemon '%s' has invalid ID %d", ErrCorruptSave, path, pokemon.Name, pokemon.ID)
+		}
+		if err := pokedex.insert(pokemon); err != nil {
+			return Pokedex{}, fmt.Errorf("%w: %s: pokemon #%d: %w", ErrCorruptSave, path, pokemon.ID, err)
+		}
+	}
+	for _, name := range save.Seen {
+		pokedex.MarkSeen(name)
+	}
+	// Keep IDs of released Pokémon from being reused
+	pokedex.nextID = max(pokedex.nextID, save.NextID)
+
+	return pokedex, nil
+}
@@ -0,0 +1,152 @@
+package pokeapi
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "pokedex.json")
+
+	pokedex := NewPokedex()
+	for _, name := range []string{"pikachu", "bulbasaur", "pikachu"} {
+		pokedex.AddPokemon(Pokemon{Name: name, Height: 4})
+	}
+	pokedex.MarkSeen("rattata")
+	if _, err := pokedex.SetNickname(2, "bulby"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Released IDs must not be handed out again after a reload
+	if _, err := pokedex.RemovePokemon(3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := pokedex.Save(path); err != nil {
+		t.Fatalf("unexpected error saving: %v", err)
+	}
+
+	loaded, err := LoadPokedex(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading: %v", err)
+	}
+
+	pokemon, err := loaded.GetPokemon(1)
+	if err != nil {
+		t.Fatalf("expected to find pokemon #1: %v", err)
+	}
+	if pokemon.Name != "pikachu" || pokemon.Height != 4 {
+		t.Errorf("expected pikachu with height 4, got %s with height %d", pokemon.Name, pokemon.Height)
+	}
+	for _, name := range []string{"rattata", "pikachu", "bulbasaur"} {
+		if !loaded.HasSeen(name) {
+			t.Errorf("expected %s to be seen", name)
+		}
+	}
+	if found, ok := loaded.FindNickname("bulby"); !ok || found.ID != 2 {
+		t.Errorf("expected nickname bulby to be #2, got %v", found)
+	}
+	if len(loaded.GetAllPokemon()) != 2 {
+		t.Errorf("expected 2 pokemon, got %d", len(loaded.GetAllPokemon()))
+	}
+	if added := loaded.AddPokemon(Pokemon{Name: "eevee"}); added.ID != 4 {
+		t.Errorf("expected new pokemon to get ID 4, got %d", added.ID)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the save file in directory, got %d entries", len(entries))
+	}
+}
+
+func TestLoadVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pokedex.json")
+	contents := `{"version": 1, "pokemon": [{"name": "bulbasaur"}, {"name": "pikachu"}]}`
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	loaded, err := LoadPokedex(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading: %v", err)
+	}
+
+	all := loaded.GetAllPokemon()
+	if len(all) != 2 {
+		t.Fatalf("expected 2 pokemon, got %d", len(all))
+	}
+	for i, name := range []string{"bulbasaur", "pikachu"} {
+		if all[i].ID != i+1 || all[i].Name != name {
+			t.Errorf("expected #%d %s, got #%d %s", i+1, name, all[i].ID, all[i].Name)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	cases := []struct {
+		name     string
+		contents string
+		expected error
+	}{
+		{
+			name:     "corrupt.json",
+			contents: `{"version": 1, "pokemon": [`,
+			expected: ErrCorruptSave,
+		},
+		{
+			name:     "unversioned.json",
+			contents: `{"pokemon": []}`,
+			expected: ErrCorruptSave,
+		},
+		{
+			name:     "missing-id.json",
+			contents: `{"version": 2, "pokemon": [{"name": "pikachu"}]}`,
+			expected: ErrCorruptSave,
+		},
+		{
+			name:     "duplicate-id.json",
+			contents: `{"version": 2, "pokemon": [{"id": 1, "name": "pikachu"}, {"id": 1, "name": "eevee"}]}`,
+			expected: ErrCorruptSave,
+		},
+		{
+			name:     "duplicate-nickname.json",
+			contents: `{"version": 2, "pokemon": [{"id": 1, "name": "pikachu", "nickname": "sparky"}, {"id": 2, "name": "eevee", "nickname": "sparky"}]}`,
+			expected: ErrCorruptSave,
+		},
+		{
+			name:     "newer.json",
+			contents: `{"version": 999, "pokemon": []}`,
+			expected: ErrSaveVersionTooNew,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := filepath.Join(dir, c.name)
+			if err := os.WriteFile(path, []byte(c.contents), 0o644); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err := LoadPokedex(path)
+			if !errors.Is(err, c.expected) {
+				t.Errorf("expected error %v, got %v", c.expected, err)
+			}
+		})
+	}
+
+	_, err := LoadPokedex(filepath.Join(dir, "duplicate-id.json"))
+	if !errors.Is(err, ErrAddDuplicatePokemon) {
+		t.Errorf("expected ErrAddDuplicatePokemon, got %v", err)
+	}
+
+	_, err = LoadPokedex(filepath.Join(dir, "missing.json"))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist, got %v", err)
+	}
+}
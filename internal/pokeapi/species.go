@@ -0,0 +1,76 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+type APIResource struct {
+	URL string `json:"url"`
+}
+
+type FlavorText struct {
+	Language   NamedAPIResource `json:"language"`
+	Version    NamedAPIResource `json:"version"`
+	FlavorText string           `json:"flavor_text"`
+}
+
+type Genus struct {
+	Language NamedAPIResource `json:"language"`
+	Genus    string           `json:"genus"`
+}
+
+type PokemonSpeciesDexEntry struct {
+	Pokedex     NamedAPIResource `json:"pokedex"`
+	EntryNumber int              `json:"entry_number"`
+}
+
+type PokemonSpeciesVariety struct {
+	Pokemon   NamedAPIResource `json:"pokemon"`
+	IsDefault bool             `json:"is_default"`
+}
+
+type PokemonSpeciesResponse struct {
+	GrowthRate         NamedAPIResource         `json:"growth_rate"`
+	Generation         NamedAPIResource         `json:"generation"`
+	Habitat            NamedAPIResource         `json:"habitat"`
+	EvolvesFromSpecies NamedAPIResource         `json:"evolves_from_species"`
+	EvolutionChain     APIResource              `json:"evolution_chain"`
+	FlavorTextEntries  []FlavorText             `json:"flavor_text_entries"`
+	Genera             []Genus                  `json:"genera"`
+	Names              []Name                   `json:"names"`
+	PokedexNumbers     []PokemonSpeciesDexEntry `json:"pokedex_numbers"`
+	Varieties          []PokemonSpeciesVariety  `json:"varieties"`
+	Name               string                   `json:"name"`
+	ID                 int                      `json:"id"`
+	Order              int                      `json:"order"`
+	CaptureRate        int                      `json:"capture_rate"`
+	BaseHappiness      int                      `json:"base_happiness"`
+	GenderRate         int                      `json:"gender_rate"`
+	HatchCounter       int                      `json:"hatch_counter"`
+	IsBaby             bool                     `json:"is_baby"`
+	IsLegendary        bool                     `json:"is_legendary"`
+	IsMythical         bool                     `json:"is_mythical"`
+}
+
+// FlavorText returns the most recent Pokédex entry in the given language,
+// preferring version when it has one. PokeAPI keeps the line breaks and form
+// feeds of the original games, which are replaced by spaces.
+func (r *PokemonSpeciesResponse) FlavorText(language, version string) string {
+	text := ""
+	for _, entry := range r.FlavorTextEntries {
+		if entry.Language.Name != language {
+			continue
+		}
+		text = entry.FlavorText
+		if entry.Version.Name == version {
+			break
+		}
+	}
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func (c *Client) GetPokemonSpecies(ctx context.Context, speciesName string) (PokemonSpeciesResponse, error) {
+	return getResource[PokemonSpeciesResponse](ctx, c, "pokemon-species", speciesName)
+}
@@ -0,0 +1,23 @@
+package pokeapi
+
+import "testing"
+
+func TestFlavorText(t *testing.T) {
+	species := PokemonSpeciesResponse{
+		FlavorTextEntries: []FlavorText{
+			{Language: NamedAPIResource{Name: "en"}, Version: NamedAPIResource{Name: "red"}, FlavorText: "When several of\nthese POKéMON\fgather"},
+			{Language: NamedAPIResource{Name: "ja"}, Version: NamedAPIResource{Name: "red"}, FlavorText: "ほっぺたの"},
+			{Language: NamedAPIResource{Name: "en"}, Version: NamedAPIResource{Name: "sword"}, FlavorText: "Pikachu that can\ngenerate powerful electricity"},
+		},
+	}
+
+	if actual := species.FlavorText("en", "red"); actual != "When several of these POKéMON gather" {
+		t.Errorf("unexpected flavor text for red: '%s'", actual)
+	}
+	if actual := species.FlavorText("en", ""); actual != "Pikachu that can generate powerful electricity" {
+		t.Errorf("expected latest entry, got '%s'", actual)
+	}
+	if actual := species.FlavorText("fr", ""); actual != "" {
+		t.Errorf("expected no entry, got '%s'", actual)
+	}
+}
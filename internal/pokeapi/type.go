@@ -0,0 +1,137 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+)
+
+type TypeRelations struct {
+	NoDamageTo       []NamedAPIResource `json:"no_damage_to"`
+	HalfDamageTo     []NamedAPIResource `json:"half_damage_to"`
+	DoubleDamageTo   []NamedAPIResource `json:"double_damage_to"`
+	NoDamageFrom     []NamedAPIResource `json:"no_damage_from"`
+	HalfDamageFrom   []NamedAPIResource `json:"half_damage_from"`
+	DoubleDamageFrom []NamedAPIResource `json:"double_damage_from"`
+}
+
+func (r TypeRelations) empty() bool {
+	return len(r.NoDamageTo)+len(r.HalfDamageTo)+len(r.DoubleDamageTo)+
+		len(r.NoDamageFrom)+len(r.HalfDamageFrom)+len(r.DoubleDamageFrom) == 0
+}
+
+// TypePastRelations holds damage relations up to and including Generation.
+type TypePastRelations struct {
+	Generation      NamedAPIResource `json:"generation"`
+	DamageRelations TypeRelations    `json:"damage_relations"`
+}
+
+type TypePokemon struct {
+	Pokemon NamedAPIResource `json:"pokemon"`
+	Slot    int              `json:"slot"`
+}
+
+type TypeResponse struct {
+	DamageRelations     TypeRelations       `json:"damage_relations"`
+	Generation          NamedAPIResource    `json:"generation"`
+	PastDamageRelations []TypePastRelations `json:"past_damage_relations"`
+	Pokemon             []TypePokemon       `json:"pokemon"`
+	Name                string              `json:"name"`
+	ID                  int                 `json:"id"`
+}
+
+// RelationsIn returns the type's damage relations in the generation with ID
+// generation; zero means the current relations.
+func (r *TypeResponse) RelationsIn(generation int) TypeRelations {
+	lastGenerations := []NamedAPIResource{}
+	for _, past := range r.PastDamageRelations {
+		lastGenerations = append(lastGenerations, past.Generation)
+	}
+	if i := pastIndex(lastGenerations, generation); i >= 0 {
+		return r.PastDamageRelations[i].DamageRelations
+	}
+
+	return r.DamageRelations
+}
+
+func (c *Client) GetType(ctx context.Context, typeName string) (TypeResponse, error) {
+	return getResource[TypeResponse](ctx, c, "type", typeName)
+}
+
+// TypeChart holds the damage multiplier of every attacking type against
+// every defending type.
+type TypeChart struct {
+	multipliers map[string]map[string]float64
+	types       []string
+}
+
+// NewTypeChart builds a chart from the damage relations of types. Types
+// without any damage relations, such as "unknown", are left out.
+func NewTypeChart(types []TypeResponse) TypeChart {
+	chart := TypeChart{
+		multipliers: make(map[string]map[string]float64),
+		types:       []string{},
+	}
+
+	for _, t := range types {
+		if t.DamageRelations.empty() {
+			continue
+		}
+		chart.types = append(chart.types, t.Name)
+
+		row := make(map[string]float64)
+		for _, defender := range t.DamageRelations.NoDamageTo {
+			row[defender.Name] = 0
+		}
+		for _, defender := range t.DamageRelations.HalfDamageTo {
+			row[defender.Name] = 0.5
+		}
+		for _, defender := range t.DamageRelations.DoubleDamageTo {
+			row[defender.Name] = 2
+		}
+		chart.multipliers[t.Name] = row
+	}
+
+	return chart
+}
+
+// Types lists the attacking types in the chart.
+func (t TypeChart) Types() []string {
+	return t.types
+}
+
+// Multiplier returns the damage multiplier of an attack against a Pokémon
+// with the given (one or two) defending types.
+func (t TypeChart) Multiplier(attack string, defense ...string) float64 {
+	multiplier := 1.0
+	for _, defender := range defense {
+		if m, ok := t.multipliers[attack][defender]; ok {
+			multiplier *= m
+		}
+	}
+
+	return multiplier
+}
+
+// GetTypeChart fetches every type and builds the chart as it was in the
+// generation with ID generation; zero means the current chart.
+func (c *Client) GetTypeChart(ctx context.Context, generation int) (TypeChart, error) {
+	resources, err := c.GetResourceList(ctx, "type")
+	if err != nil {
+		return TypeChart{}, fmt.Errorf("error listing types: %w", err)
+	}
+
+	types := []TypeResponse{}
+	for _, resource := range resources {
+		t, err := c.GetType(ctx, resource.Name)
+		if err != nil {
+			return TypeChart{}, err
+		}
+		if !ExistsIn(t.Generation, generation) {
+			continue
+		}
+		t.DamageRelations = t.RelationsIn(generation)
+		types = append(types, t)
+	}
+
+	return NewTypeChart(types), nil
+}
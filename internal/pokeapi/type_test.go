@@ -0,0 +1,99 @@
+package pokeapi
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTypeChart(t *testing.T) {
+	resources := func(names ...string) []NamedAPIResource {
+		list := []NamedAPIResource{}
+		for _, name := range names {
+			list = append(list, NamedAPIResource{Name: name})
+		}
+		return list
+	}
+	chart := NewTypeChart([]TypeResponse{
+		{
+			Name: "electric",
+			DamageRelations: TypeRelations{
+				NoDamageTo:     resources("ground"),
+				HalfDamageTo:   resources("electric", "grass"),
+				DoubleDamageTo: resources("water", "flying"),
+			},
+		},
+		{
+			Name: "rock",
+			DamageRelations: TypeRelations{
+				HalfDamageTo:   resources("ground"),
+				DoubleDamageTo: resources("fire", "flying"),
+			},
+		},
+		{Name: "unknown"},
+	})
+
+	if len(chart.Types()) != 2 {
+		t.Errorf("expected types without relations to be skipped, got %v", chart.Types())
+	}
+
+	cases := []struct {
+		attack   string
+		defense  []string
+		expected float64
+	}{
+		{attack: "electric", defense: []string{"water"}, expected: 2},
+		{attack: "electric", defense: []string{"water", "flying"}, expected: 4},
+		{attack: "electric", defense: []string{"grass", "electric"}, expected: 0.25},
+		{attack: "electric", defense: []string{"ground", "flying"}, expected: 0},
+		{attack: "electric", defense: []string{"grass", "flying"}, expected: 1},
+		{attack: "rock", defense: []string{"fire", "flying"}, expected: 4},
+		{attack: "rock", defense: []string{"normal"}, expected: 1},
+		{attack: "fairy", defense: []string{"dragon"}, expected: 1},
+	}
+
+	for _, c := range cases {
+		if actual := chart.Multiplier(c.attack, c.defense...); actual != c.expected {
+			t.Errorf("Multiplier(%s, %v): expected %v, got %v", c.attack, c.defense, c.expected, actual)
+		}
+	}
+}
+
+func TestTypesIn(t *testing.T) {
+	generation := func(id int) NamedAPIResource {
+		return NamedAPIResource{URL: fmt.Sprintf("https://pokeapi.co/api/v2/generation/%d/", id)}
+	}
+	typed := func(names ...string) []PokemonType {
+		types := []PokemonType{}
+		for i, name := range names {
+			types = append(types, PokemonType{Type: NamedAPIResource{Name: name}, Slot: i + 1})
+		}
+		return types
+	}
+	clefairy := PokemonDetailsResponse{
+		Types: typed("fairy"),
+		PastTypes: []PokemonTypePast{
+			{Generation: generation(5), Types: typed("normal")},
+		},
+	}
+
+	cases := []struct {
+		generation int
+		expected   string
+	}{
+		{generation: 0, expected: "fairy"},
+		{generation: 1, expected: "normal"},
+		{generation: 5, expected: "normal"},
+		{generation: 6, expected: "fairy"},
+	}
+
+	for _, c := range cases {
+		types := clefairy.TypesIn(c.generation)
+		if len(types) != 1 || types[0].Type.Name != c.expected {
+			t.Errorf("generation %d: expected %s, got %v", c.generation, c.expected, types)
+		}
+	}
+
+	if !ExistsIn(generation(1), 1) || ExistsIn(generation(6), 5) || !ExistsIn(generation(6), 0) {
+		t.Error("unexpected ExistsIn result")
+	}
+}
@@ -0,0 +1,26 @@
+package pokeapi
+
+import "context"
+
+type VersionResponse struct {
+	VersionGroup NamedAPIResource `json:"version_group"`
+	Names        []Name           `json:"names"`
+	Name         string           `json:"name"`
+	ID           int              `json:"id"`
+}
+
+func (c *Client) GetVersion(ctx context.Context, versionName string) (VersionResponse, error) {
+	return getResource[VersionResponse](ctx, c, "version", versionName)
+}
+
+type VersionGroupResponse struct {
+	Generation NamedAPIResource   `json:"generation"`
+	Versions   []NamedAPIResource `json:"versions"`
+	Name       string             `json:"name"`
+	ID         int                `json:"id"`
+	Order      int                `json:"order"`
+}
+
+func (c *Client) GetVersionGroup(ctx context.Context, versionGroupName string) (VersionGroupResponse, error) {
+	return getResource[VersionGroupResponse](ctx, c, "version-group", versionGroupName)
+}
@@ -1,26 +1,59 @@
 package pokecache
 
 import (
+	"container/list"
 	"sync"
 	"time"
 )
 
 type Cache struct {
-	cache    map[string]cacheEntry
-	interval time.Duration
-	m        *sync.RWMutex
+	cache      map[string]*list.Element
+	lru        *list.List // front is the most recently used entry
+	interval   time.Duration
+	maxEntries int
+	maxBytes   int
+	size       int
+	m          *sync.Mutex
+	done       chan struct{}
+	closeOnce  sync.Once
 }
 
 type cacheEntry struct {
 	createdAt time.Time
+	key       string
 	val       []byte
 }
 
-func NewCache(interval time.Duration) Cache {
-	cache := Cache{
-		cache:    make(map[string]cacheEntry),
+// Option configures optional Cache limits.
+type Option func(*Cache)
+
+// WithMaxEntries bounds the number of entries; the least recently used entry
+// is evicted when the bound is exceeded. Zero or less means unbounded.
+func WithMaxEntries(n int) Option {
+	return func(c *Cache) {
+		c.maxEntries = n
+	}
+}
+
+// WithMaxBytes bounds the combined size of all keys and values; least
+// recently used entries are evicted until the cache fits. Values larger than
+// the whole budget are not stored. Zero or less means unbounded.
+func WithMaxBytes(n int) Option {
+	return func(c *Cache) {
+		c.maxBytes = n
+	}
+}
+
+func NewCache(interval time.Duration, opts ...Option) *Cache {
+	cache := &Cache{
+		cache:    make(map[string]*list.Element),
+		lru:      list.New(),
 		interval: interval,
-		m:        &sync.RWMutex{},
+		m:        &sync.Mutex{},
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(cache)
 	}
 
 	go cache.reapLoop()
@@ -30,30 +63,114 @@ func NewCache(interval time.Duration) Cache {
 
 func (c *Cache) Add(key string, val []byte) {
 	c.m.Lock()
-	c.cache[key] = cacheEntry{
+	defer c.m.Unlock()
+
+	if elem, ok := c.cache[key]; ok {
+		c.remove(elem)
+	}
+
+	if c.maxBytes > 0 && entrySize(key, val) > c.maxBytes {
+		return
+	}
+
+	elem := c.lru.PushFront(&cacheEntry{
 		createdAt: time.Now(),
+		key:       key,
 		val:       val,
+	})
+	c.cache[key] = elem
+	c.size += entrySize(key, val)
+
+	for c.overBudget() {
+		c.remove(c.lru.Back())
 	}
-	c.m.Unlock()
 }
 
 func (c *Cache) Get(key string) ([]byte, bool) {
-	c.m.RLock()
-	entry, ok := c.cache[key]
-	c.m.RUnlock()
-	return entry.val, ok
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	elem, ok := c.cache[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+
+	return elem.Value.(*cacheEntry).val, true
+}
+
+type Stats struct {
+	Entries int
+	Bytes   int
+}
+
+func (c *Cache) Stats() Stats {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return Stats{
+		Entries: c.lru.Len(),
+		Bytes:   c.size,
+	}
+}
+
+func (c *Cache) Clear() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	clear(c.cache)
+	c.lru.Init()
+	c.size = 0
+}
+
+func (c *Cache) overBudget() bool {
+	if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		return true
+	}
+	return c.maxBytes > 0 && c.size > c.maxBytes
+}
+
+// remove must be called with c.m held.
+func (c *Cache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.cache, entry.key)
+	c.size -= entrySize(entry.key, entry.val)
+}
+
+func entrySize(key string, val []byte) int {
+	return len(key) + len(val)
+}
+
+// Close stops the reaper goroutine. The cache remains usable afterwards but
+// entries no longer expire by age. Close is safe to call more than once.
+func (c *Cache) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
 }
 
 func (c *Cache) reapLoop() {
 	ticker := time.NewTicker(c.interval)
-	for range ticker.C {
-		c.m.Lock()
-		for k, v := range c.cache {
-			reapDeadline := v.createdAt.Add(c.interval)
-			if time.Now().After(reapDeadline) {
-				delete(c.cache, k)
-			}
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			c.reap()
+		}
+	}
+}
+
+func (c *Cache) reap() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for _, elem := range c.cache {
+		reapDeadline := elem.Value.(*cacheEntry).createdAt.Add(c.interval)
+		if time.Now().After(reapDeadline) {
+			c.remove(elem)
 		}
-		c.m.Unlock()
 	}
 }
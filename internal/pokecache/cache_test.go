@@ -2,6 +2,8 @@ package pokecache
 
 import (
 	"fmt"
+	"runtime"
+	"sync"
 	"testing"
 	"time"
 )
@@ -60,3 +62,134 @@ func TestReapLoop(t *testing.T) {
 	}
 }
 
+func TestMaxEntriesEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewCache(time.Minute, WithMaxEntries(2))
+	cache.Add("a", []byte("1"))
+	cache.Add("b", []byte("2"))
+
+	// Touch "a" so that "b" becomes the least recently used entry
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("expected to find key a")
+	}
+	cache.Add("c", []byte("3"))
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("expected to find key %s", key)
+		}
+	}
+}
+
+func TestMaxBytes(t *testing.T) {
+	cache := NewCache(time.Minute, WithMaxBytes(10))
+	cache.Add("a", []byte("1234"))
+	cache.Add("b", []byte("1234"))
+	cache.Add("c", []byte("1234"))
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to be evicted")
+	}
+	if cache.size > 10 {
+		t.Errorf("expected size within budget, got %d", cache.size)
+	}
+
+	cache.Add("huge", make([]byte, 64))
+	if _, ok := cache.Get("huge"); ok {
+		t.Errorf("expected value larger than budget to be rejected")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Errorf("expected c to survive rejected add")
+	}
+}
+
+func TestReplaceUpdatesSize(t *testing.T) {
+	cache := NewCache(time.Minute, WithMaxBytes(100))
+	cache.Add("a", []byte("1234"))
+	cache.Add("a", []byte("12"))
+
+	if cache.size != 3 {
+		t.Errorf("expected size 3, got %d", cache.size)
+	}
+	if cache.lru.Len() != 1 {
+		t.Errorf("expected 1 entry, got %d", cache.lru.Len())
+	}
+}
+
+func TestBudgetUnderConcurrentAccess(t *testing.T) {
+	const (
+		maxEntries = 16
+		maxBytes   = 256
+		workers    = 8
+		iterations = 500
+	)
+	cache := NewCache(time.Minute, WithMaxEntries(maxEntries), WithMaxBytes(maxBytes))
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				key := fmt.Sprintf("key-%d-%d", w, i%40)
+				cache.Add(key, make([]byte, i%24))
+				cache.Get(fmt.Sprintf("key-%d-%d", (w+1)%workers, i%40))
+
+				cache.m.Lock()
+				entries, size := cache.lru.Len(), cache.size
+				cache.m.Unlock()
+				if entries > maxEntries || size > maxBytes {
+					t.Errorf("budget exceeded: %d entries, %d bytes", entries, size)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	actual := 0
+	for _, elem := range cache.cache {
+		entry := elem.Value.(*cacheEntry)
+		actual += entrySize(entry.key, entry.val)
+	}
+	if actual != cache.size {
+		t.Errorf("tracked size %d does not match actual size %d", cache.size, actual)
+	}
+	if len(cache.cache) != cache.lru.Len() {
+		t.Errorf("map has %d entries but list has %d", len(cache.cache), cache.lru.Len())
+	}
+}
+
+func TestCloseStopsReaper(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	caches := []*Cache{}
+	for range 10 {
+		caches = append(caches, NewCache(time.Millisecond))
+	}
+	if runtime.NumGoroutine() < before+len(caches) {
+		t.Fatalf("expected a reaper goroutine per cache")
+	}
+
+	for _, cache := range caches {
+		cache.Close()
+		// Closing twice must not panic
+		cache.Close()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d goroutines after close, got %d", before, runtime.NumGoroutine())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cache := caches[0]
+	cache.Add("https://example.com", []byte("testdata"))
+	if _, ok := cache.Get("https://example.com"); !ok {
+		t.Errorf("expected closed cache to remain usable")
+	}
+}
@@ -0,0 +1,178 @@
+package pokecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const diskEntryExt = ".json"
+
+// DiskCache stores one file per key in a directory so that cached entries
+// survive restarts. It is safe for concurrent use: entries are written to a
+// temporary file and renamed into place.
+type DiskCache struct {
+	dir    string
+	maxAge time.Duration
+}
+
+type diskEntry struct {
+	Key       string    `json:"key"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Val       []byte    `json:"val"`
+}
+
+type DiskStats struct {
+	Oldest  time.Time
+	Entries int
+	Bytes   int64
+}
+
+// NewDiskCache creates dir if needed. Entries older than maxAge are treated
+// as missing; zero or less means entries never expire.
+func NewDiskCache(dir string, maxAge time.Duration) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating cache directory: %w", err)
+	}
+
+	return &DiskCache{
+		dir:    dir,
+		maxAge: maxAge,
+	}, nil
+}
+
+func (d *DiskCache) Dir() string {
+	return d.dir
+}
+
+func (d *DiskCache) Add(key string, val []byte) error {
+	data, err := json.Marshal(diskEntry{
+		Key:       key,
+		FetchedAt: time.Now(),
+		Val:       val,
+	})
+	if err != nil {
+		return fmt.Errorf("error during Marshal: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(d.dir, ".entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating cache entry: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		return fmt.Errorf("error writing cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the value stored for key. Unreadable, expired or colliding
+// entries are reported as missing.
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	entry, err := readDiskEntry(d.path(key))
+	if err != nil || entry.Key != key || d.expired(entry) {
+		return nil, false
+	}
+
+	return entry.Val, true
+}
+
+func (d *DiskCache) Clear() error {
+	files, err := d.entryFiles()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		err := os.Remove(file)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error removing cache entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (d *DiskCache) Stats() (DiskStats, error) {
+	files, err := d.entryFiles()
+	if err != nil {
+		return DiskStats{}, err
+	}
+
+	var stats DiskStats
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		entry, err := readDiskEntry(file)
+		if err != nil || d.expired(entry) {
+			continue
+		}
+
+		stats.Entries++
+		stats.Bytes += info.Size()
+		if stats.Oldest.IsZero() || entry.FetchedAt.Before(stats.Oldest) {
+			stats.Oldest = entry.FetchedAt
+		}
+	}
+
+	return stats, nil
+}
+
+func (d *DiskCache) expired(entry diskEntry) bool {
+	return d.maxAge > 0 && time.Since(entry.FetchedAt) > d.maxAge
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+diskEntryExt)
+}
+
+func (d *DiskCache) entryFiles() ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cache directory: %w", err)
+	}
+
+	files := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), diskEntryExt) {
+			continue
+		}
+		files = append(files, filepath.Join(d.dir, entry.Name()))
+	}
+
+	return files, nil
+}
+
+func readDiskEntry(path string) (diskEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return diskEntry{}, err
+	}
+
+	var entry diskEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return diskEntry{}, err
+	}
+
+	return entry, nil
+}
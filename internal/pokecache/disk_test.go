@@ -0,0 +1,94 @@
+package pokecache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiskAddGet(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const key = "https://example.com/path?offset=20"
+	if err := cache.Add(key, []byte("testdata")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A fresh instance simulates a restart
+	reopened, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	val, ok := reopened.Get(key)
+	if !ok {
+		t.Fatalf("expected to find key")
+	}
+	if string(val) != "testdata" {
+		t.Errorf("expected 'testdata', got '%s'", val)
+	}
+
+	if _, ok := reopened.Get("https://example.com/other"); ok {
+		t.Errorf("expected to not find key")
+	}
+}
+
+func TestDiskMaxAge(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir(), time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cache.Add("https://example.com", []byte("testdata")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := cache.Get("https://example.com"); ok {
+		t.Errorf("expected expired entry to be missing")
+	}
+}
+
+func TestDiskStatsClear(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		if err := cache.Add(key, []byte("testdata")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// Unrelated files in the directory are left alone
+	unrelated := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(unrelated, []byte("keep"), 0o644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stats, err := cache.Stats()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.Entries != 3 || stats.Bytes == 0 || stats.Oldest.IsZero() {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	if err := cache.Clear(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stats, err = cache.Stats()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.Entries != 0 {
+		t.Errorf("expected no entries after clear, got %d", stats.Entries)
+	}
+	if _, err := os.Stat(unrelated); err != nil {
+		t.Errorf("expected unrelated file to remain: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func cmdAbility(ctx context.Context, cfg *config, args []string) error {
+	ability, err := cfg.client.GetAbility(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting ability: %w", err)
+	}
+	if !pokeapi.ExistsIn(ability.Generation, cfg.generation) {
+		fmt.Printf("%s does not exist in %s; it was introduced in %s.\n", ability.Name, cfg.version, ability.Generation.Name)
+		return nil
+	}
+
+	fmt.Printf("Name: %s\n", ability.Name)
+	fmt.Printf("Introduced in: %s\n", ability.Generation.Name)
+	if effect := ability.Effect("en"); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+
+	if len(ability.Pokemon) == 0 {
+		return nil
+	}
+	fmt.Println("Pokémon:")
+	for _, p := range ability.Pokemon {
+		if p.IsHidden {
+			fmt.Printf("\t- %s (hidden)\n", p.Pokemon.Name)
+		} else {
+			fmt.Printf("\t- %s\n", p.Pokemon.Name)
+		}
+	}
+
+	return nil
+}
+
+// abilityLines lists a Pokémon's current abilities followed by the ones it
+// had in earlier generations.
+func abilityLines(pokemon pokeapi.PokemonDetailsResponse) []string {
+	lines := []string{}
+	for _, ability := range pokemon.Abilities {
+		lines = append(lines, abilityLabel(ability))
+	}
+	for _, past := range pokemon.PastAbilities {
+		for _, ability := range past.Abilities {
+			// Past entries without an ability mark a slot that was empty
+			if ability.AbilityInfo.Name == "" {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("%s (until %s)", abilityLabel(ability), past.Generation.Name))
+		}
+	}
+
+	return lines
+}
+
+func abilityLabel(ability pokeapi.PokemonAbility) string {
+	if ability.IsHidden {
+		return ability.AbilityInfo.Name + " (hidden)"
+	}
+	return ability.AbilityInfo.Name
+}
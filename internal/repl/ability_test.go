@@ -0,0 +1,111 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestAbilityLines(t *testing.T) {
+	ability := func(name string, hidden bool) pokeapi.PokemonAbility {
+		return pokeapi.PokemonAbility{AbilityInfo: named(name), IsHidden: hidden}
+	}
+
+	cases := []struct {
+		name     string
+		pokemon  pokeapi.PokemonDetailsResponse
+		expected []string
+	}{
+		{
+			name: "hidden ability",
+			pokemon: pokeapi.PokemonDetailsResponse{
+				Abilities: []pokeapi.PokemonAbility{ability("static", false), ability("lightning-rod", true)},
+			},
+			expected: []string{"static", "lightning-rod (hidden)"},
+		},
+		{
+			name: "past ability",
+			pokemon: pokeapi.PokemonDetailsResponse{
+				Abilities: []pokeapi.PokemonAbility{ability("cursed-body", false)},
+				PastAbilities: []pokeapi.PokemonAbilityPast{
+					{
+						Generation: named("generation-vi"),
+						Abilities:  []pokeapi.PokemonAbility{ability("levitate", false)},
+					},
+				},
+			},
+			expected: []string{"cursed-body", "levitate (until generation-vi)"},
+		},
+		{
+			name: "empty past slot",
+			pokemon: pokeapi.PokemonDetailsResponse{
+				Abilities: []pokeapi.PokemonAbility{ability("overgrow", false)},
+				PastAbilities: []pokeapi.PokemonAbilityPast{
+					{
+						Generation: named("generation-iv"),
+						Abilities:  []pokeapi.PokemonAbility{{IsHidden: true}},
+					},
+				},
+			},
+			expected: []string{"overgrow"},
+		},
+	}
+
+	for _, c := range cases {
+		if actual := abilityLines(c.pokemon); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestCmdAbility(t *testing.T) {
+	cfg := testConfig(t, map[string]string{
+		"/ability/static": `{
+			"name": "static",
+			"generation": {"name": "generation-iii"},
+			"effect_entries": [{"effect": "Contact may\nparalyze.", "language": {"name": "en"}}],
+			"pokemon": [
+				{"pokemon": {"name": "pikachu"}, "is_hidden": false},
+				{"pokemon": {"name": "electrike"}, "is_hidden": true}
+			]
+		}`,
+	})
+
+	cases := []struct {
+		args     []string
+		expected []string
+		err      error
+	}{
+		{
+			args: []string{"static"},
+			expected: []string{
+				"Introduced in: generation-iii",
+				"Effect: Contact may paralyze.",
+				"\t- pikachu\n",
+				"\t- electrike (hidden)\n",
+			},
+		},
+		{
+			args: []string{"statik"},
+			err:  pokeapi.ErrNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		output, err := captureOutput(t, func() error {
+			return cmdAbility(context.Background(), cfg, c.args)
+		})
+		if !errors.Is(err, c.err) {
+			t.Errorf("%v: expected error %v, got %v", c.args, c.err, err)
+		}
+		for _, line := range c.expected {
+			if !strings.Contains(output, line) {
+				t.Errorf("%v: expected output to contain %q, got:\n%s", c.args, line, output)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+// neutralNature stands in when no nature can be looked up.
+var neutralNature = pokeapi.Nature{Name: "hardy"}
+
+// individualize rolls what makes a caught Pokémon unique: level within its
+// encounter slot, IVs, gender and shininess. The nature is rolled before the
+// throw, so that nothing can fail once the Pokémon is caught.
+func individualize(pokemon *pokeapi.Pokemon, wild pokeapi.WildEncounter, species pokeapi.PokemonSpeciesResponse, nature pokeapi.Nature) {
+	pokemon.Level = pokeapi.RollLevel(wild.Encounter.MinLevel, wild.Encounter.MaxLevel)
+	pokemon.IVs = pokeapi.RollIVs(pokemon.Stats)
+	pokemon.Nature = nature
+	pokemon.Gender = pokeapi.RollGender(species.GenderRate)
+	pokemon.Shiny = pokeapi.RollShiny()
+}
+
+func randomNature(ctx context.Context, cfg *config) (pokeapi.Nature, error) {
+	natures, err := cfg.client.GetResourceList(ctx, "nature")
+	if err != nil {
+		return pokeapi.Nature{}, fmt.Errorf("error getting natures: %w", err)
+	}
+	if len(natures) == 0 {
+		return pokeapi.Nature{}, errors.New("no natures available")
+	}
+
+	response, err := cfg.client.GetNature(ctx, natures[rand.Intn(len(natures))].Name)
+	if err != nil {
+		return pokeapi.Nature{}, fmt.Errorf("error getting nature: %w", err)
+	}
+
+	return response.ToNature(), nil
+}
+
+func natureLabel(nature pokeapi.Nature) string {
+	if nature.IncreasedStat == "" || nature.IncreasedStat == nature.DecreasedStat {
+		return nature.Name + " (neutral)"
+	}
+	return fmt.Sprintf("%s (+%s, -%s)", nature.Name, nature.IncreasedStat, nature.DecreasedStat)
+}
@@ -0,0 +1,70 @@
+package repl
+
+import (
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestCmdCatchNature(t *testing.T) {
+	area := pokeapi.LocationDetailsResponse{
+		Name: "route-1-area",
+		PokemonEncounters: []pokeapi.PokemonEncounter{
+			{
+				Pokemon: named("pidgey"),
+				VersionDetails: []pokeapi.VersionEncounterDetail{
+					{Version: named("red"), EncounterDetails: []pokeapi.Encounter{{Method: named("walk"), Chance: 50, MinLevel: 3, MaxLevel: 3}}},
+				},
+			},
+		},
+	}
+	pidgey := map[string]string{
+		"/pokemon/pidgey":         `{"name": "pidgey", "species": {"name": "pidgey"}, "stats": [{"base_stat": 40, "stat": {"name": "hp"}}]}`,
+		"/pokemon-species/pidgey": `{"name": "pidgey", "capture_rate": 255, "gender_rate": 4}`,
+	}
+	withNatures := map[string]string{
+		"/nature":         `{"results": [{"name": "adamant"}]}`,
+		"/nature/adamant": `{"name": "adamant", "increased_stat": {"name": "attack"}, "decreased_stat": {"name": "special-attack"}}`,
+	}
+	for path, body := range pidgey {
+		withNatures[path] = body
+	}
+
+	cases := []struct {
+		name     string
+		routes   map[string]string
+		expected pokeapi.Nature
+	}{
+		{
+			name:     "rolled nature",
+			routes:   withNatures,
+			expected: pokeapi.Nature{Name: "adamant", IncreasedStat: "attack", DecreasedStat: "special-attack"},
+		},
+		{
+			// Natures cannot be looked up, which must not lose the catch
+			name:     "neutral fallback",
+			routes:   pidgey,
+			expected: neutralNature,
+		},
+	}
+
+	for _, c := range cases {
+		cfg := testConfig(t, c.routes)
+		cfg.area = &area
+
+		catchUntilCaught(t, cfg, []string{"pidgey"})
+
+		caught := cfg.pokedex.FindSpecies("pidgey")
+		if len(caught) != 1 {
+			t.Errorf("%s: expected one pidgey to be caught, got %v", c.name, caught)
+			continue
+		}
+		pokemon := caught[0]
+		if pokemon.Nature != c.expected {
+			t.Errorf("%s: expected nature %+v, got %+v", c.name, c.expected, pokemon.Nature)
+		}
+		if pokemon.Level != 3 || pokemon.IVs["hp"] > 31 {
+			t.Errorf("%s: unexpected level %d or IVs %v", c.name, pokemon.Level, pokemon.IVs)
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package repl
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"strconv"
+	"time"
 
 	"github.com/bekadoux/pokedex/internal/pokeapi"
 )
@@ -13,18 +15,39 @@ type cmd struct {
 	description string
 	minArgs     int
 	maxArgs     int
-	callback    func(*config, []string) error
+	mutates     bool
+	callback    func(context.Context, *config, []string) error
 }
 
 type config struct {
 	client              pokeapi.Client
 	pokedex             pokeapi.Pokedex
+	savePath            string
+	typeChart           *pokeapi.TypeChart
 	NextLocationURL     string
 	PreviousLocationURL string
+	// area is the location area the player is in and wild the Pokémon
+	// currently encountered there, if any.
+	area *pokeapi.LocationDetailsResponse
+	wild *pokeapi.WildEncounter
+	// version and versionGroup scope command output to one game, such as
+	// "red" in "red-blue". Empty means all versions. generation is the ID of
+	// its generation and versionGroupOrder its place among version groups,
+	// both zero without a version.
+	version           string
+	versionGroup      string
+	generation        int
+	versionGroupOrder int
+	// unsaved is set by commands that are not marked as mutating when they
+	// do change the Pokédex, such as by seeing a new Pokémon.
+	unsaved bool
 }
 
 var cmdRegistry map[string]cmd
 
+// errExit is returned by the exit command to end the REPL loop.
+var errExit = errors.New("exit requested")
+
 func init() {
 	cmdRegistry = map[string]cmd{
 		"exit": {
@@ -32,8 +55,8 @@ func init() {
 			description: "Exit the Pokédex",
 			minArgs:     0,
 			maxArgs:     0,
-			callback: func(cfg *config, args []string) error {
-				return cmdExit()
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return errExit
 			},
 		},
 		"help": {
@@ -41,17 +64,26 @@ func init() {
 			description: "Displays a help message",
 			minArgs:     0,
 			maxArgs:     0,
-			callback: func(cfg *config, args []string) error {
+			callback: func(ctx context.Context, cfg *config, args []string) error {
 				return cmdHelp()
 			},
 		},
+		"version": {
+			name:        "version",
+			description: "Show or select the game version all commands are scoped to: 'version [name|all]'",
+			minArgs:     0,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdVersion(ctx, cfg, args)
+			},
+		},
 		"map": {
 			name:        "map",
 			description: "Displays 20 location areas in the Pokémon world (each subsequent call displays the next 20 locations)",
 			minArgs:     0,
 			maxArgs:     0,
-			callback: func(cfg *config, args []string) error {
-				return cmdMapFwd(cfg)
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdMapFwd(ctx, cfg)
 			},
 		},
 		"mapb": {
@@ -59,35 +91,99 @@ func init() {
 			description: "Return to previous map page",
 			minArgs:     0,
 			maxArgs:     0,
-			callback: func(cfg *config, args []string) error {
-				return cmdMapBack(cfg)
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdMapBack(ctx, cfg)
+			},
+		},
+		"regions": {
+			name:        "regions",
+			description: "List the regions of the Pokémon world",
+			minArgs:     0,
+			maxArgs:     0,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdRegions(ctx, cfg)
+			},
+		},
+		"region": {
+			name:        "region",
+			description: "List the locations in a region",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdRegion(ctx, cfg, args)
+			},
+		},
+		"location": {
+			name:        "location",
+			description: "List the explorable areas of a location",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdLocation(ctx, cfg, args)
 			},
 		},
 		"explore": {
 			name:        "explore",
-			description: "Explore location area",
+			description: "Explore location area, by default the current one: 'explore [area] [--details] [--items] [--version=<name>]'",
+			minArgs:     0,
+			maxArgs:     4,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdExplore(ctx, cfg, args)
+			},
+		},
+		"where": {
+			name:        "where",
+			description: "Find where a Pokémon lives: 'where <pokemon> [version]'",
+			minArgs:     1,
+			maxArgs:     2,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdWhere(ctx, cfg, args)
+			},
+		},
+		"travel": {
+			name:        "travel",
+			description: "Travel to a location area",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdTravel(ctx, cfg, args)
+			},
+		},
+		"goto": {
+			name:        "goto",
+			description: "Alias for travel",
 			minArgs:     1,
 			maxArgs:     1,
-			callback: func(cfg *config, args []string) error {
-				return cmdExplore(cfg, args)
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdTravel(ctx, cfg, args)
+			},
+		},
+		"encounter": {
+			name:        "encounter",
+			description: "Look for a wild Pokémon in the current location area",
+			minArgs:     0,
+			maxArgs:     0,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdEncounter(cfg)
 			},
 		},
 		"catch": {
 			name:        "catch",
-			description: "Catch a Pokémon!",
-			minArgs:     1,
+			description: "Catch the wild Pokémon you encountered, or one living in the current area: 'catch [pokemon]'",
+			minArgs:     0,
 			maxArgs:     1,
-			callback: func(cfg *config, args []string) error {
-				return cmdCatch(cfg, args)
+			mutates:     true,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdCatch(ctx, cfg, args)
 			},
 		},
 		"inspect": {
 			name:        "inspect",
-			description: "Inspect a Pokémon in your Pokédex",
+			description: "Inspect a Pokémon in your Pokédex by ID, nickname or species: 'inspect <id|name>'",
 			minArgs:     1,
 			maxArgs:     1,
-			callback: func(cfg *config, args []string) error {
-				return cmdInspect(cfg, args)
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdInspect(ctx, cfg, args)
 			},
 		},
 		"pokedex": {
@@ -95,19 +191,123 @@ func init() {
 			description: "List all Pokémon in your Pokédex",
 			minArgs:     0,
 			maxArgs:     0,
-			callback: func(cfg *config, args []string) error {
+			callback: func(ctx context.Context, cfg *config, args []string) error {
 				return cmdPokedex(cfg)
 			},
 		},
+		"release": {
+			name:        "release",
+			description: "Release a caught Pokémon: 'release <id|nickname>'",
+			minArgs:     1,
+			maxArgs:     1,
+			mutates:     true,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdRelease(cfg, args)
+			},
+		},
+		"nickname": {
+			name:        "nickname",
+			description: fmt.Sprintf("Give a caught Pokémon a nickname of up to %d characters: 'nickname <id|name> <nickname>'", pokeapi.MaxNicknameLength),
+			minArgs:     2,
+			maxArgs:     2,
+			mutates:     true,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdNickname(ctx, cfg, args)
+			},
+		},
+		"progress": {
+			name:        "progress",
+			description: "Show how many Pokémon you have seen and caught per generation",
+			minArgs:     0,
+			maxArgs:     0,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdProgress(ctx, cfg)
+			},
+		},
+		"evolution": {
+			name:        "evolution",
+			description: "Show how a Pokémon evolves",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdEvolution(ctx, cfg, args)
+			},
+		},
+		"move": {
+			name:        "move",
+			description: "Show details of a move",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdMove(ctx, cfg, args)
+			},
+		},
+		"learnset": {
+			name:        "learnset",
+			description: "List the moves a Pokémon learns: 'learnset <pokemon> [version-group]'",
+			minArgs:     1,
+			maxArgs:     2,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdLearnset(ctx, cfg, args)
+			},
+		},
+		"ability": {
+			name:        "ability",
+			description: "Show what an ability does and which Pokémon have it",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdAbility(ctx, cfg, args)
+			},
+		},
+		"weakness": {
+			name:        "weakness",
+			description: "Show which attack types are effective against a Pokémon",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdWeakness(ctx, cfg, args)
+			},
+		},
+		"item": {
+			name:        "item",
+			description: "Show details of an item or berry",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdItem(ctx, cfg, args)
+			},
+		},
+		"cache": {
+			name:        "cache",
+			description: "Manage the response cache: 'cache stats' or 'cache clear'",
+			minArgs:     1,
+			maxArgs:     1,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdCache(cfg, args)
+			},
+		},
+		"save": {
+			name:        "save",
+			description: "Save your Pokédex to disk",
+			minArgs:     0,
+			maxArgs:     0,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdSave(cfg)
+			},
+		},
+		"load": {
+			name:        "load",
+			description: "Reload your Pokédex from disk, discarding unsaved changes",
+			minArgs:     0,
+			maxArgs:     0,
+			callback: func(ctx context.Context, cfg *config, args []string) error {
+				return cmdLoad(cfg)
+			},
+		},
 	}
 }
 
-func cmdExit() error {
-	fmt.Println("Closing the Pokedex... Goodbye!")
-	os.Exit(0)
-	return nil
-}
-
 func cmdHelp() error {
 	fmt.Printf("Welcome to the Pokedex!\nUsage:\n\n")
 
@@ -117,8 +317,8 @@ func cmdHelp() error {
 	return nil
 }
 
-func cmdMapFwd(cfg *config) error {
-	locResponse, err := cfg.client.GetLocationAreas(cfg.NextLocationURL)
+func cmdMapFwd(ctx context.Context, cfg *config) error {
+	locResponse, err := cfg.client.GetLocationAreas(ctx, cfg.NextLocationURL)
 	if err != nil {
 		return fmt.Errorf("error getting location areas: %w", err)
 	}
@@ -133,13 +333,13 @@ func cmdMapFwd(cfg *config) error {
 	return nil
 }
 
-func cmdMapBack(cfg *config) error {
+func cmdMapBack(ctx context.Context, cfg *config) error {
 	if len(cfg.PreviousLocationURL) == 0 {
 		fmt.Println("You're on the first page.")
 		return nil
 	}
 
-	response, err := cfg.client.GetLocationAreas(cfg.PreviousLocationURL)
+	response, err := cfg.client.GetLocationAreas(ctx, cfg.PreviousLocationURL)
 	if err != nil {
 		return fmt.Errorf("error getting location areas: %w", err)
 	}
@@ -154,46 +354,115 @@ func cmdMapBack(cfg *config) error {
 	return nil
 }
 
-func cmdExplore(cfg *config, args []string) error {
-	location := args[0]
-	response, err := cfg.client.GetLocationDetails(args[0])
+func cmdExplore(ctx context.Context, cfg *config, args []string) error {
+	positional, flags, err := parseFlags(args, "items", "details", "version")
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 && cfg.area != nil {
+		positional = append(positional, cfg.area.Name)
+	}
+	if len(positional) != 1 {
+		return errors.New("explore expects a location area")
+	}
+	_, showItems := flags["items"]
+	_, showDetails := flags["details"]
+	version, ok := flags["version"]
+	if !ok {
+		version = cfg.version
+	}
+
+	location := positional[0]
+	response, err := cfg.client.GetLocationDetails(ctx, location)
 	if err != nil {
 		return fmt.Errorf("error getting location areas: %w", err)
 	}
 
 	fmt.Printf("Exploring %s...\n", location)
-	if len(response.PokemonEncounters) > 0 {
-		fmt.Println("Found Pokemon:")
+	if response.Location.Name != "" {
+		fmt.Printf("Part of %s (see 'location %s')\n", response.Location.Name, response.Location.Name)
+	}
+	if showDetails {
+		printEncounterMethodRates(response.EncounterMethodRates, version)
 	}
-	for _, encounter := range response.PokemonEncounters {
-		fmt.Printf("- %s\n", encounter.Pokemon.Name)
+
+	encounters := filterEncounters(response.PokemonEncounters, version)
+	if len(encounters) == 0 {
+		if version != "" {
+			fmt.Printf("No Pokemon found in %s.\n", version)
+		}
+		return nil
+	}
+
+	fmt.Println("Found Pokemon:")
+	if showDetails {
+		printEncounterTable(encounters)
+	}
+	for _, encounter := range encounters {
+		markSeen(cfg, encounter.Pokemon.Name)
+		if !showDetails {
+			fmt.Printf("- %s\n", encounter.Pokemon.Name)
+		}
+
+		if showItems {
+			pokemon, err := cfg.client.GetPokemonDetails(ctx, encounter.Pokemon.Name)
+			if err != nil {
+				return fmt.Errorf("error getting pokemon details: %w", err)
+			}
+			if showDetails && len(pokemon.HeldItems) > 0 {
+				fmt.Printf("- %s\n", encounter.Pokemon.Name)
+			}
+			printHeldItems(pokemon.HeldItems, "  ", version)
+		}
 	}
 
 	return nil
 }
 
-func cmdCatch(cfg *config, args []string) error {
-	name := args[0]
-	response, err := cfg.client.GetPokemonDetails(name)
+func cmdCatch(ctx context.Context, cfg *config, args []string) error {
+	wild, current, err := wildTarget(cfg, args)
+	if err != nil {
+		return err
+	}
+
+	name := wild.Pokemon.Name
+	if !current {
+		// A named catch rolls its own encounter, which the player meets here
+		fmt.Printf("A wild %s appeared! (%s)\n", name, wild.Encounter.Method.Name)
+		markSeen(cfg, name)
+	}
+	response, err := cfg.client.GetPokemonDetails(ctx, name)
 	if err != nil {
 		return fmt.Errorf("error getting pokemon details: %w", err)
 	}
 
+	species, err := cfg.client.GetPokemonSpecies(ctx, response.Species.Name)
+	if err != nil {
+		return fmt.Errorf("error getting pokemon species: %w", err)
+	}
+
+	// A failed lookup must not cost the player a catch, so the nature is
+	// settled before the throw and falls back to a neutral one
+	nature, err := randomNature(ctx, cfg)
+	if err != nil {
+		nature = neutralNature
+	}
+
 	fmt.Printf("Throwing a Pokeball at %s...\n", name)
 
-	// Max possible base_experience, set arbitrarily for simplicity
-	maxBaseExp := 350
-	catchSuccess := pokeapi.AttemptCatchPokemon(response.BaseExperience, maxBaseExp, 0.05, 0.95)
+	catchSuccess := pokeapi.AttemptCatchPokemon(species.CaptureRate, 0.05, 0.95)
 	if catchSuccess {
 		pokemon := response.ToPokemon()
-		err = cfg.pokedex.AddPokemon(pokemon)
-		if errors.Is(err, pokeapi.ErrAddDuplicatePokemon) {
-			fmt.Printf("You already have a %s!\n", name)
-		} else if err != nil {
-			return fmt.Errorf("error adding pokemon '%s' to pokedex: %w", name, err)
-		} else {
-			fmt.Printf("%s was caught!\n", name)
-			fmt.Println("You may now inspect it with the inspect command.")
+		individualize(&pokemon, wild, species, nature)
+		pokemon = cfg.pokedex.AddPokemon(pokemon)
+		if pokemon.Shiny {
+			fmt.Println("It's shiny!")
+		}
+		fmt.Printf("%s (lv %d) was caught and registered as #%d!\n", name, pokemon.Level, pokemon.ID)
+		fmt.Println("You may now inspect it with the inspect command.")
+		// A named catch leaves the current wild Pokémon in place
+		if current {
+			cfg.wild = nil
 		}
 	} else {
 		fmt.Printf("%s escaped!\n", name)
@@ -202,27 +471,71 @@ func cmdCatch(cfg *config, args []string) error {
 	return nil
 }
 
-func cmdInspect(cfg *config, args []string) error {
-	name := args[0]
-	pokemon, err := cfg.pokedex.GetPokemon(name)
-	if errors.Is(err, pokeapi.ErrGetAbsentPokemon) {
-		fmt.Printf("You haven't caught a %s yet!\n", name)
+func cmdInspect(ctx context.Context, cfg *config, args []string) error {
+	ref := args[0]
+	found := findCaught(cfg, ref)
+	if len(found) == 0 {
+		if _, err := strconv.Atoi(ref); err == nil {
+			fmt.Printf("You have no Pokémon with ID %s.\n", ref)
+		} else {
+			fmt.Printf("You haven't caught a %s yet!\n", ref)
+		}
 		return nil
 	}
-	if err != nil {
-		return fmt.Errorf("error inspecting pokemon '%s': %w", name, err)
+	if len(found) > 1 {
+		fmt.Printf("You have %d %s; inspect one by ID:\n", len(found), ref)
+		for _, pokemon := range found {
+			fmt.Printf("\t- %s\n", pokemonLabel(pokemon))
+		}
+		return nil
 	}
+	pokemon := found[0]
 
+	fmt.Printf("ID: %d\n", pokemon.ID)
+	if pokemon.Nickname != "" {
+		fmt.Printf("Nickname: %s\n", pokemon.Nickname)
+	}
 	fmt.Printf("Name: %s\nHeight: %d\nWeight: %d\n", pokemon.Name, pokemon.Height, pokemon.Weight)
+	if pokemon.Level > 0 {
+		fmt.Printf("Level: %d\n", pokemon.Level)
+		fmt.Printf("Gender: %s\n", pokemon.Gender)
+		fmt.Printf("Nature: %s\n", natureLabel(pokemon.Nature))
+		if pokemon.Shiny {
+			fmt.Println("Shiny: yes")
+		}
+	}
 	fmt.Println("Stats:")
 	for _, stat := range pokemon.Stats {
-		fmt.Printf("\t- %s: %d\n", stat.Stat.Name, stat.BaseStat)
+		if pokemon.Level > 0 {
+			fmt.Printf("\t- %s: %d (base %d, IV %d)\n", stat.Stat.Name, pokemon.ActualStat(stat), stat.BaseStat, pokemon.IVs[stat.Stat.Name])
+		} else {
+			fmt.Printf("\t- %s: %d\n", stat.Stat.Name, stat.BaseStat)
+		}
 	}
 	fmt.Println("Types:")
 	for _, pType := range pokemon.Types {
 		fmt.Printf("\t- %s\n", pType.Type.Name)
 	}
 
+	// Only what sets a caught Pokémon apart is stored; the rest is looked
+	// up, and inspect still works from the stored record if that fails
+	details, err := cfg.client.GetPokemonDetails(ctx, pokemon.Name)
+	if err != nil {
+		fmt.Printf("Further details are unavailable: %v\n", err)
+		return nil
+	}
+
+	fmt.Println("Abilities:")
+	for _, line := range abilityLines(details) {
+		fmt.Printf("\t- %s\n", line)
+	}
+	for _, index := range details.GameIndices {
+		if index.Version.Name == cfg.version {
+			fmt.Printf("Game index (%s): %d\n", cfg.version, index.Index)
+		}
+	}
+	printHeldItems(details.HeldItems, "", cfg.version)
+
 	return nil
 }
 
@@ -235,7 +548,60 @@ func cmdPokedex(cfg *config) error {
 
 	fmt.Println("Your Pokédex:")
 	for _, p := range allPokemon {
-		fmt.Printf("\t- %s\n", p.Name)
+		fmt.Printf("\t- %s\n", pokemonLabel(p))
+	}
+
+	return nil
+}
+
+func cmdSave(cfg *config) error {
+	err := cfg.pokedex.Save(cfg.savePath)
+	if err != nil {
+		return fmt.Errorf("error saving pokedex: %w", err)
+	}
+
+	fmt.Printf("Pokédex saved to %s\n", cfg.savePath)
+
+	return nil
+}
+
+func cmdLoad(cfg *config) error {
+	pokedex, err := pokeapi.LoadPokedex(cfg.savePath)
+	if err != nil {
+		return fmt.Errorf("error loading pokedex: %w", err)
+	}
+	cfg.pokedex = pokedex
+
+	fmt.Printf("Pokédex loaded from %s\n", cfg.savePath)
+
+	return nil
+}
+
+func cmdCache(cfg *config, args []string) error {
+	switch args[0] {
+	case "stats":
+		stats, err := cfg.client.CacheStats()
+		if err != nil {
+			return fmt.Errorf("error getting cache stats: %w", err)
+		}
+
+		fmt.Printf("Memory: %d entries, %d bytes\n", stats.Memory.Entries, stats.Memory.Bytes)
+		if !stats.DiskEnabled {
+			fmt.Println("Disk: disabled")
+			return nil
+		}
+		fmt.Printf("Disk: %d entries, %d bytes in %s\n", stats.Disk.Entries, stats.Disk.Bytes, stats.DiskDir)
+		if stats.Disk.Entries > 0 {
+			fmt.Printf("Oldest disk entry fetched %s\n", stats.Disk.Oldest.Format(time.DateTime))
+		}
+	case "clear":
+		err := cfg.client.ClearCache()
+		if err != nil {
+			return fmt.Errorf("error clearing cache: %w", err)
+		}
+		fmt.Println("Cache cleared.")
+	default:
+		return fmt.Errorf("unknown cache subcommand: '%s'", args[0])
 	}
 
 	return nil
@@ -0,0 +1,185 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+// encounterSummary merges the encounter slots sharing a method and set of
+// conditions into one level range and total chance.
+type encounterSummary struct {
+	method     string
+	conditions string
+	minLevel   int
+	maxLevel   int
+	chance     int
+}
+
+func (s encounterSummary) levels() string {
+	if s.maxLevel != s.minLevel {
+		return fmt.Sprintf("lv %d-%d", s.minLevel, s.maxLevel)
+	}
+	return fmt.Sprintf("lv %d", s.minLevel)
+}
+
+func (s encounterSummary) String() string {
+	text := fmt.Sprintf("%s, %s, %d%%", s.method, s.levels(), s.chance)
+	if s.conditions != "" {
+		text += " (" + s.conditions + ")"
+	}
+
+	return text
+}
+
+func summarizeEncounters(encounters []pokeapi.Encounter) []encounterSummary {
+	summaries := []encounterSummary{}
+	index := make(map[string]int)
+
+	for _, encounter := range encounters {
+		conditions := []string{}
+		for _, condition := range encounter.ConditionValues {
+			conditions = append(conditions, condition.Name)
+		}
+		sort.Strings(conditions)
+
+		summary := encounterSummary{
+			method:     encounter.Method.Name,
+			conditions: strings.Join(conditions, ", "),
+			minLevel:   encounter.MinLevel,
+			maxLevel:   encounter.MaxLevel,
+			chance:     encounter.Chance,
+		}
+
+		key := summary.method + "|" + summary.conditions
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(summaries)
+			summaries = append(summaries, summary)
+			continue
+		}
+		summaries[i].minLevel = min(summaries[i].minLevel, summary.minLevel)
+		summaries[i].maxLevel = max(summaries[i].maxLevel, summary.maxLevel)
+		summaries[i].chance += summary.chance
+	}
+
+	return summaries
+}
+
+// filterEncounters keeps only the version details for version, dropping
+// Pokémon that do not appear in it. An empty version keeps everything.
+func filterEncounters(encounters []pokeapi.PokemonEncounter, version string) []pokeapi.PokemonEncounter {
+	if version == "" {
+		return encounters
+	}
+
+	filtered := []pokeapi.PokemonEncounter{}
+	for _, encounter := range encounters {
+		for _, versionDetail := range encounter.VersionDetails {
+			if versionDetail.Version.Name == version {
+				filtered = append(filtered, pokeapi.PokemonEncounter{
+					Pokemon:        encounter.Pokemon,
+					VersionDetails: []pokeapi.VersionEncounterDetail{versionDetail},
+				})
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+func printEncounterTable(encounters []pokeapi.PokemonEncounter) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "POKEMON\tVERSION\tMETHOD\tLEVELS\tCHANCE\tCONDITIONS")
+	for _, encounter := range encounters {
+		for _, versionDetail := range encounter.VersionDetails {
+			for _, summary := range summarizeEncounters(versionDetail.EncounterDetails) {
+				conditions := summary.conditions
+				if conditions == "" {
+					conditions = "-"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d%%\t%s\n", encounter.Pokemon.Name, versionDetail.Version.Name, summary.method, summary.levels(), summary.chance, conditions)
+			}
+		}
+	}
+	w.Flush()
+}
+
+func printEncounterMethodRates(rates []pokeapi.EncounterMethodRate, version string) {
+	lines := []string{}
+	for _, rate := range rates {
+		parts := []string{}
+		for _, detail := range rate.VersionDetails {
+			if version == "" {
+				parts = append(parts, fmt.Sprintf("%d%% (%s)", detail.Rate, detail.Version.Name))
+			} else if detail.Version.Name == version {
+				parts = append(parts, fmt.Sprintf("%d%%", detail.Rate))
+			}
+		}
+		if len(parts) > 0 {
+			lines = append(lines, fmt.Sprintf("\t- %s: %s", rate.EncounterMethod.Name, strings.Join(parts, ", ")))
+		}
+	}
+
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Println("Encounter method rates:")
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
+
+func cmdWhere(ctx context.Context, cfg *config, args []string) error {
+	name := args[0]
+	version := cfg.version
+	if len(args) > 1 {
+		version = args[1]
+	}
+
+	areas, err := cfg.client.GetPokemonEncounters(ctx, name)
+	if err != nil {
+		return fmt.Errorf("error getting encounters: %w", err)
+	}
+
+	found := false
+	for _, area := range areas {
+		lines := []string{}
+		for _, versionDetail := range area.VersionDetails {
+			if version != "" && versionDetail.Version.Name != version {
+				continue
+			}
+			for _, summary := range summarizeEncounters(versionDetail.EncounterDetails) {
+				lines = append(lines, fmt.Sprintf("\t- %s: %s", versionDetail.Version.Name, summary))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		if !found {
+			fmt.Printf("%s can be found in:\n", name)
+			found = true
+		}
+		fmt.Println(area.LocationArea.Name)
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	}
+
+	if !found {
+		if version != "" {
+			fmt.Printf("%s cannot be found in the wild in %s.\n", name, version)
+		} else {
+			fmt.Printf("%s cannot be found in the wild.\n", name)
+		}
+	}
+
+	return nil
+}
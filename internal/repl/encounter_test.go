@@ -0,0 +1,113 @@
+package repl
+
+import (
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestSummarizeEncounters(t *testing.T) {
+	encounters := []pokeapi.Encounter{
+		{Method: named("walk"), MinLevel: 3, MaxLevel: 3, Chance: 20},
+		{Method: named("walk"), MinLevel: 5, MaxLevel: 6, Chance: 10},
+		{Method: named("walk"), MinLevel: 7, MaxLevel: 7, Chance: 5, ConditionValues: []pokeapi.NamedAPIResource{named("time-night")}},
+		{Method: named("surf"), MinLevel: 20, MaxLevel: 20, Chance: 60},
+	}
+
+	expected := []string{
+		"walk, lv 3-6, 30%",
+		"walk, lv 7, 5% (time-night)",
+		"surf, lv 20, 60%",
+	}
+	actual := summarizeEncounters(encounters)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d summaries, got %v", len(expected), actual)
+	}
+	for i := range expected {
+		if actual[i].String() != expected[i] {
+			t.Errorf("expected '%s', got '%s'", expected[i], actual[i])
+		}
+	}
+}
+
+func TestFilterEncounters(t *testing.T) {
+	encounters := []pokeapi.PokemonEncounter{
+		{Pokemon: named("pidgey"), VersionDetails: []pokeapi.VersionEncounterDetail{{Version: named("red")}, {Version: named("blue")}}},
+		{Pokemon: named("oddish"), VersionDetails: []pokeapi.VersionEncounterDetail{{Version: named("red")}}},
+		{Pokemon: named("bellsprout"), VersionDetails: []pokeapi.VersionEncounterDetail{{Version: named("blue")}}},
+	}
+
+	cases := []struct {
+		version  string
+		expected []string
+	}{
+		{version: "", expected: []string{"pidgey", "oddish", "bellsprout"}},
+		{version: "red", expected: []string{"pidgey", "oddish"}},
+		{version: "blue", expected: []string{"pidgey", "bellsprout"}},
+		{version: "gold", expected: []string{}},
+	}
+
+	for _, c := range cases {
+		actual := filterEncounters(encounters, c.version)
+		if len(actual) != len(c.expected) {
+			t.Errorf("%q: expected %v, got %v", c.version, c.expected, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i].Pokemon.Name != c.expected[i] {
+				t.Errorf("%q: expected %s at %d, got %s", c.version, c.expected[i], i, actual[i].Pokemon.Name)
+			}
+			if c.version == "" {
+				continue
+			}
+			if len(actual[i].VersionDetails) != 1 || actual[i].VersionDetails[0].Version.Name != c.version {
+				t.Errorf("%q: expected only %s version details for %s, got %v", c.version, c.version, actual[i].Pokemon.Name, actual[i].VersionDetails)
+			}
+		}
+	}
+}
+
+func TestPrintEncounterMethodRates(t *testing.T) {
+	rates := []pokeapi.EncounterMethodRate{
+		{EncounterMethod: named("walk"), VersionDetails: []pokeapi.EncounterVersionDetails{
+			{Version: named("red"), Rate: 25},
+			{Version: named("blue"), Rate: 20},
+		}},
+		{EncounterMethod: named("old-rod"), VersionDetails: []pokeapi.EncounterVersionDetails{
+			{Version: named("blue"), Rate: 100},
+		}},
+	}
+
+	cases := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "",
+			expected: "Encounter method rates:\n\t- walk: 25% (red), 20% (blue)\n\t- old-rod: 100% (blue)\n",
+		},
+		{
+			version:  "red",
+			expected: "Encounter method rates:\n\t- walk: 25%\n",
+		},
+		{
+			version:  "blue",
+			expected: "Encounter method rates:\n\t- walk: 20%\n\t- old-rod: 100%\n",
+		},
+		{
+			// Nothing is printed, not even the heading
+			version:  "gold",
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		output, _ := captureOutput(t, func() error {
+			printEncounterMethodRates(rates, c.version)
+			return nil
+		})
+		if output != c.expected {
+			t.Errorf("%q: expected %q, got %q", c.version, c.expected, output)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func cmdEvolution(ctx context.Context, cfg *config, args []string) error {
+	name := args[0]
+	pokemon, err := cfg.client.GetPokemonDetails(ctx, name)
+	if err != nil {
+		return fmt.Errorf("error getting pokemon details: %w", err)
+	}
+
+	species, err := cfg.client.GetPokemonSpecies(ctx, pokemon.Species.Name)
+	if err != nil {
+		return fmt.Errorf("error getting pokemon species: %w", err)
+	}
+
+	chainID, err := pokeapi.ResourceID(species.EvolutionChain.URL)
+	if err != nil {
+		return fmt.Errorf("error finding evolution chain of '%s': %w", species.Name, err)
+	}
+
+	chain, err := cfg.client.GetEvolutionChain(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("error getting evolution chain: %w", err)
+	}
+
+	if !pokeapi.ExistsIn(species.Generation, cfg.generation) {
+		fmt.Printf("%s does not exist in %s.\n", species.Name, cfg.version)
+		return nil
+	}
+
+	roots := []pokeapi.ChainLink{chain.Chain}
+	if cfg.generation != 0 {
+		exists := make(map[string]bool)
+		if err := speciesExistence(ctx, cfg, chain.Chain, exists); err != nil {
+			return err
+		}
+		roots = chainIn(chain.Chain, exists)
+	}
+
+	fmt.Printf("Evolution chain of %s:\n", species.Name)
+	for _, root := range roots {
+		fmt.Print(renderChain(root, species.Name))
+	}
+
+	return nil
+}
+
+// speciesExistence records for every species of the chain whether it exists
+// in the selected version.
+func speciesExistence(ctx context.Context, cfg *config, link pokeapi.ChainLink, exists map[string]bool) error {
+	species, err := cfg.client.GetPokemonSpecies(ctx, link.Species.Name)
+	if err != nil {
+		return fmt.Errorf("error getting pokemon species: %w", err)
+	}
+	exists[link.Species.Name] = pokeapi.ExistsIn(species.Generation, cfg.generation)
+
+	for _, next := range link.EvolvesTo {
+		if err := speciesExistence(ctx, cfg, next, exists); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chainIn drops the species that do not exist from the chain. A species
+// whose pre-evolution was dropped, such as Pikachu without Pichu, starts a
+// chain of its own.
+func chainIn(link pokeapi.ChainLink, exists map[string]bool) []pokeapi.ChainLink {
+	next := []pokeapi.ChainLink{}
+	for _, child := range link.EvolvesTo {
+		next = append(next, chainIn(child, exists)...)
+	}
+
+	if !exists[link.Species.Name] {
+		for i := range next {
+			next[i].EvolutionDetails = nil
+		}
+		return next
+	}
+
+	link.EvolvesTo = next
+	return []pokeapi.ChainLink{link}
+}
+
+// renderChain draws the chain as an indented tree, one species per line,
+// marking the species the user asked about.
+func renderChain(chain pokeapi.ChainLink, highlight string) string {
+	var b strings.Builder
+	writeChainLink(&b, chain, highlight, 0)
+	return b.String()
+}
+
+func writeChainLink(b *strings.Builder, link pokeapi.ChainLink, highlight string, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString("- ")
+	b.WriteString(link.Species.Name)
+	if link.IsBaby {
+		b.WriteString(" (baby)")
+	}
+
+	conditions := []string{}
+	for _, detail := range link.EvolutionDetails {
+		conditions = append(conditions, describeEvolution(detail))
+	}
+	if len(conditions) > 0 {
+		b.WriteString(": ")
+		b.WriteString(strings.Join(conditions, " or "))
+	}
+
+	if link.Species.Name == highlight {
+		b.WriteString(" <")
+	}
+	b.WriteString("\n")
+
+	for _, next := range link.EvolvesTo {
+		writeChainLink(b, next, highlight, depth+1)
+	}
+}
+
+func describeEvolution(detail pokeapi.EvolutionDetail) string {
+	parts := []string{}
+
+	switch detail.Trigger.Name {
+	case "level-up":
+		if detail.MinLevel > 0 {
+			parts = append(parts, fmt.Sprintf("level %d", detail.MinLevel))
+		} else {
+			parts = append(parts, "level up")
+		}
+	case "use-item":
+		parts = append(parts, "use "+detail.Item.Name)
+	case "trade":
+		parts = append(parts, "trade")
+	default:
+		parts = append(parts, detail.Trigger.Name)
+	}
+
+	if detail.Trigger.Name != "use-item" && detail.Item.Name != "" {
+		parts = append(parts, "with "+detail.Item.Name)
+	}
+	if detail.HeldItem.Name != "" {
+		parts = append(parts, "holding "+detail.HeldItem.Name)
+	}
+	if detail.TradeSpecies.Name != "" {
+		parts = append(parts, "for "+detail.TradeSpecies.Name)
+	}
+	if detail.MinHappiness > 0 {
+		parts = append(parts, fmt.Sprintf("happiness %d+", detail.MinHappiness))
+	}
+	if detail.MinBeauty > 0 {
+		parts = append(parts, fmt.Sprintf("beauty %d+", detail.MinBeauty))
+	}
+	if detail.MinAffection > 0 {
+		parts = append(parts, fmt.Sprintf("affection %d+", detail.MinAffection))
+	}
+	if detail.KnownMove.Name != "" {
+		parts = append(parts, "knowing "+detail.KnownMove.Name)
+	}
+	if detail.KnownMoveType.Name != "" {
+		parts = append(parts, "knowing a "+detail.KnownMoveType.Name+" move")
+	}
+	if detail.Location.Name != "" {
+		parts = append(parts, "at "+detail.Location.Name)
+	}
+	if detail.TimeOfDay != "" {
+		parts = append(parts, "during "+detail.TimeOfDay)
+	}
+	switch detail.Gender {
+	case 1:
+		parts = append(parts, "female only")
+	case 2:
+		parts = append(parts, "male only")
+	}
+	if detail.PartySpecies.Name != "" {
+		parts = append(parts, "with "+detail.PartySpecies.Name+" in party")
+	}
+	if detail.PartyType.Name != "" {
+		parts = append(parts, "with a "+detail.PartyType.Name+" type in party")
+	}
+	if detail.RelativePhysicalStats != nil {
+		switch {
+		case *detail.RelativePhysicalStats > 0:
+			parts = append(parts, "attack > defense")
+		case *detail.RelativePhysicalStats < 0:
+			parts = append(parts, "attack < defense")
+		default:
+			parts = append(parts, "attack = defense")
+		}
+	}
+	if detail.NeedsOverworldRain {
+		parts = append(parts, "while raining")
+	}
+	if detail.TurnUpsideDown {
+		parts = append(parts, "holding the console upside down")
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,98 @@
+package repl
+
+import (
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func named(name string) pokeapi.NamedAPIResource {
+	return pokeapi.NamedAPIResource{Name: name}
+}
+
+func TestRenderChain(t *testing.T) {
+	chain := pokeapi.ChainLink{
+		Species: named("eevee"),
+		EvolvesTo: []pokeapi.ChainLink{
+			{
+				Species:          named("vaporeon"),
+				EvolutionDetails: []pokeapi.EvolutionDetail{{Trigger: named("use-item"), Item: named("water-stone")}},
+			},
+			{
+				Species: named("espeon"),
+				EvolutionDetails: []pokeapi.EvolutionDetail{
+					{Trigger: named("level-up"), MinHappiness: 160, TimeOfDay: "day"},
+				},
+			},
+		},
+	}
+
+	expected := "- eevee\n" +
+		"  - vaporeon: use water-stone <\n" +
+		"  - espeon: level up, happiness 160+, during day\n"
+	if actual := renderChain(chain, "vaporeon"); actual != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestChainIn(t *testing.T) {
+	chain := pokeapi.ChainLink{
+		Species: named("pichu"),
+		IsBaby:  true,
+		EvolvesTo: []pokeapi.ChainLink{
+			{
+				Species:          named("pikachu"),
+				EvolutionDetails: []pokeapi.EvolutionDetail{{Trigger: named("level-up"), MinHappiness: 220}},
+				EvolvesTo: []pokeapi.ChainLink{
+					{
+						Species:          named("raichu"),
+						EvolutionDetails: []pokeapi.EvolutionDetail{{Trigger: named("use-item"), Item: named("thunder-stone")}},
+					},
+				},
+			},
+		},
+	}
+	exists := map[string]bool{"pikachu": true, "raichu": true}
+
+	roots := chainIn(chain, exists)
+	if len(roots) != 1 {
+		t.Fatalf("expected 1 root, got %d", len(roots))
+	}
+
+	expected := "- pikachu <\n" +
+		"  - raichu: use thunder-stone\n"
+	if actual := renderChain(roots[0], "pikachu"); actual != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestDescribeEvolution(t *testing.T) {
+	attackBelowDefense := -1
+	cases := []struct {
+		detail   pokeapi.EvolutionDetail
+		expected string
+	}{
+		{
+			detail:   pokeapi.EvolutionDetail{Trigger: named("level-up"), MinLevel: 16},
+			expected: "level 16",
+		},
+		{
+			detail:   pokeapi.EvolutionDetail{Trigger: named("trade"), HeldItem: named("metal-coat")},
+			expected: "trade, holding metal-coat",
+		},
+		{
+			detail:   pokeapi.EvolutionDetail{Trigger: named("trade"), TradeSpecies: named("shelmet")},
+			expected: "trade, for shelmet",
+		},
+		{
+			detail:   pokeapi.EvolutionDetail{Trigger: named("level-up"), MinLevel: 20, RelativePhysicalStats: &attackBelowDefense},
+			expected: "level 20, attack < defense",
+		},
+	}
+
+	for _, c := range cases {
+		if actual := describeEvolution(c.detail); actual != c.expected {
+			t.Errorf("expected '%s', got '%s'", c.expected, actual)
+		}
+	}
+}
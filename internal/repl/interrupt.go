@@ -0,0 +1,62 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// interruptHandler turns SIGINT into cancellation of the running command
+// instead of terminating the process.
+type interruptHandler struct {
+	sigs   chan os.Signal
+	m      sync.Mutex
+	cancel context.CancelFunc
+}
+
+func newInterruptHandler() *interruptHandler {
+	h := &interruptHandler{
+		sigs: make(chan os.Signal, 1),
+	}
+	signal.Notify(h.sigs, os.Interrupt)
+
+	go h.run()
+
+	return h
+}
+
+func (h *interruptHandler) run() {
+	for range h.sigs {
+		h.m.Lock()
+		if h.cancel != nil {
+			h.cancel()
+		} else {
+			fmt.Print("\n(type 'exit' to quit)\nPokedex > ")
+		}
+		h.m.Unlock()
+	}
+}
+
+// commandContext returns a context that is cancelled on SIGINT. The returned
+// function must be called once the command finishes.
+func (h *interruptHandler) commandContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h.m.Lock()
+	h.cancel = cancel
+	h.m.Unlock()
+
+	return ctx, func() {
+		h.m.Lock()
+		h.cancel = nil
+		h.m.Unlock()
+		cancel()
+	}
+}
+
+func (h *interruptHandler) stop() {
+	signal.Stop(h.sigs)
+	close(h.sigs)
+}
@@ -0,0 +1,115 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func cmdItem(ctx context.Context, cfg *config, args []string) error {
+	item, err := cfg.client.GetItem(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting item: %w", err)
+	}
+
+	fmt.Printf("Name: %s\n", item.Name)
+	fmt.Printf("Category: %s\n", item.Category.Name)
+	if item.Cost > 0 {
+		fmt.Printf("Cost: %d\n", item.Cost)
+	}
+	if effect := item.Effect("en"); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+
+	if berryName, ok := strings.CutSuffix(item.Name, "-berry"); ok {
+		berry, err := cfg.client.GetBerry(ctx, berryName)
+		// Not every "-berry" item has berry data
+		if err == nil {
+			printBerry(berry)
+		} else if !errors.Is(err, pokeapi.ErrNotFound) {
+			return fmt.Errorf("error getting berry: %w", err)
+		}
+	}
+
+	if len(item.HeldByPokemon) > 0 {
+		lines := []string{}
+		for _, holder := range item.HeldByPokemon {
+			if rarity := rarities(holder.VersionDetails, cfg.version); rarity != "" {
+				lines = append(lines, fmt.Sprintf("\t- %s: %s", holder.Pokemon.Name, rarity))
+			}
+		}
+		if len(lines) > 0 {
+			fmt.Println("Held by wild Pokémon:")
+			for _, line := range lines {
+				fmt.Println(line)
+			}
+		}
+	}
+
+	return nil
+}
+
+func printBerry(berry pokeapi.BerryResponse) {
+	fmt.Printf("Firmness: %s\n", berry.Firmness.Name)
+	fmt.Printf("Growth time: %d hours per stage\n", berry.GrowthTime)
+	fmt.Printf("Natural Gift: %s, power %d\n", berry.NaturalGiftType.Name, berry.NaturalGiftPower)
+
+	flavors := []string{}
+	for _, flavor := range berry.Flavors {
+		if flavor.Potency > 0 {
+			flavors = append(flavors, fmt.Sprintf("%s %d", flavor.Flavor.Name, flavor.Potency))
+		}
+	}
+	if len(flavors) > 0 {
+		fmt.Printf("Flavors: %s\n", strings.Join(flavors, ", "))
+	}
+}
+
+// printHeldItems lists the items a wild Pokémon may hold in version, or in
+// any version if it is empty.
+func printHeldItems(heldItems []pokeapi.PokemonHeldItem, indent, version string) {
+	lines := []string{}
+	for _, held := range heldItems {
+		if rarity := rarities(held.VersionDetails, version); rarity != "" {
+			lines = append(lines, fmt.Sprintf("%s\t- %s: %s", indent, held.Item.Name, rarity))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	fmt.Printf("%sHeld items:\n", indent)
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
+
+// rarities summarizes per-version rarity, grouping versions that share a
+// rarity, e.g. "5% (red, blue), 50% (sun)". Only version is included when it
+// is not empty.
+func rarities(details []pokeapi.PokemonHeldItemVersion, version string) string {
+	versionsByRarity := make(map[int][]string)
+	for _, detail := range details {
+		if version != "" && detail.Version.Name != version {
+			continue
+		}
+		versionsByRarity[detail.Rarity] = append(versionsByRarity[detail.Rarity], detail.Version.Name)
+	}
+
+	rarityValues := []int{}
+	for rarity := range versionsByRarity {
+		rarityValues = append(rarityValues, rarity)
+	}
+	sort.Ints(rarityValues)
+
+	parts := []string{}
+	for _, rarity := range rarityValues {
+		parts = append(parts, fmt.Sprintf("%d%% (%s)", rarity, strings.Join(versionsByRarity[rarity], ", ")))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,71 @@
+package repl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestCmdItem(t *testing.T) {
+	cfg := testConfig(t, map[string]string{
+		"/item/oran-berry": `{"name": "oran-berry", "category": {"name": "medicine"}, "cost": 20}`,
+		"/berry/oran":      `{"name": "oran", "firmness": {"name": "super-hard"}, "growth_time": 4, "natural_gift_type": {"name": "poison"}, "natural_gift_power": 60}`,
+		"/item/mystery-berry": `{"name": "mystery-berry", "category": {"name": "held-items"},
+			"held_by_pokemon": [{"pokemon": {"name": "smoochum"}, "version_details": [{"rarity": 5, "version": {"name": "gold"}}]}]}`,
+	})
+
+	cases := []struct {
+		name     string
+		expected []string
+	}{
+		{
+			name:     "oran-berry",
+			expected: []string{"Category: medicine", "Cost: 20", "Firmness: super-hard", "Natural Gift: poison, power 60"},
+		},
+		{
+			// The berry lookup 404s, which must not fail the item itself
+			name:     "mystery-berry",
+			expected: []string{"Category: held-items", "\t- smoochum: 5% (gold)"},
+		},
+	}
+
+	for _, c := range cases {
+		output, err := captureOutput(t, func() error {
+			return cmdItem(context.Background(), cfg, []string{c.name})
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		for _, line := range c.expected {
+			if !strings.Contains(output, line) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", c.name, line, output)
+			}
+		}
+	}
+}
+
+func TestRarities(t *testing.T) {
+	details := []pokeapi.PokemonHeldItemVersion{
+		{Version: named("red"), Rarity: 5},
+		{Version: named("blue"), Rarity: 5},
+		{Version: named("sun"), Rarity: 50},
+	}
+
+	cases := []struct {
+		version  string
+		expected string
+	}{
+		{version: "", expected: "5% (red, blue), 50% (sun)"},
+		{version: "sun", expected: "50% (sun)"},
+		{version: "gold", expected: ""},
+	}
+
+	for _, c := range cases {
+		if actual := rarities(details, c.version); actual != c.expected {
+			t.Errorf("rarities(%q): expected '%s', got '%s'", c.version, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func cmdMove(ctx context.Context, cfg *config, args []string) error {
+	move, err := cfg.client.GetMove(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting move: %w", err)
+	}
+	if !pokeapi.ExistsIn(move.Generation, cfg.generation) {
+		fmt.Printf("%s does not exist in %s.\n", move.Name, cfg.version)
+		return nil
+	}
+	move, err = moveInVersion(ctx, cfg, move)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Name: %s\n", move.Name)
+	fmt.Printf("Type: %s\n", move.Type.Name)
+	fmt.Printf("Damage class: %s\n", move.DamageClass.Name)
+	fmt.Printf("Power: %s\n", optionalInt(move.Power))
+	fmt.Printf("Accuracy: %s\n", optionalInt(move.Accuracy))
+	fmt.Printf("PP: %s\n", optionalInt(move.PP))
+	if move.Priority != 0 {
+		fmt.Printf("Priority: %+d\n", move.Priority)
+	}
+	if effect := move.Effect("en"); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+
+	return nil
+}
+
+// moveInVersion rolls back changes made to the move after the selected
+// version.
+func moveInVersion(ctx context.Context, cfg *config, move pokeapi.MoveResponse) (pokeapi.MoveResponse, error) {
+	if cfg.versionGroup == "" {
+		return move, nil
+	}
+
+	orders := make(map[string]int)
+	for _, past := range move.PastValues {
+		name := past.VersionGroup.Name
+		if _, ok := orders[name]; ok {
+			continue
+		}
+		versionGroup, err := cfg.client.GetVersionGroup(ctx, name)
+		if err != nil {
+			return pokeapi.MoveResponse{}, fmt.Errorf("error getting version group: %w", err)
+		}
+		orders[name] = versionGroup.Order
+	}
+
+	return move.InVersionGroup(cfg.versionGroupOrder, orders), nil
+}
+
+func cmdLearnset(ctx context.Context, cfg *config, args []string) error {
+	name := args[0]
+	moves, err := pokemonMoves(ctx, cfg, name)
+	if err != nil {
+		return err
+	}
+
+	versionGroups := pokeapi.MoveVersionGroups(moves)
+	if len(versionGroups) == 0 {
+		fmt.Printf("%s cannot learn any moves.\n", name)
+		return nil
+	}
+
+	versionGroup := cfg.versionGroup
+	if len(args) > 1 {
+		versionGroup = args[1]
+	}
+	if versionGroup == "" {
+		versionGroup = versionGroups[len(versionGroups)-1]
+	}
+
+	learnset := pokeapi.Learnset(moves, versionGroup)
+	if len(learnset) == 0 {
+		fmt.Printf("%s learns no moves in %s.\n", name, versionGroup)
+		return nil
+	}
+
+	fmt.Printf("Learnset of %s in %s:\n", name, versionGroup)
+	for _, method := range learnMethods(learnset) {
+		fmt.Printf("%s:\n", method)
+		for _, learned := range learnset[method] {
+			if method == "level-up" {
+				fmt.Printf("\t- lv %d %s\n", learned.Level, learned.Move)
+			} else {
+				fmt.Printf("\t- %s\n", learned.Move)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pokemonMoves accepts caught Pokémon by ID or nickname as well as any
+// Pokémon by name. Moves are looked up rather than stored, as they are the
+// same for every Pokémon of a kind.
+func pokemonMoves(ctx context.Context, cfg *config, name string) ([]pokeapi.PokemonMove, error) {
+	if caught := findCaught(cfg, name); len(caught) > 0 {
+		name = caught[0].Name
+	}
+
+	response, err := cfg.client.GetPokemonDetails(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pokemon details: %w", err)
+	}
+
+	return response.Moves, nil
+}
+
+// learnMethods orders level-up moves first and the other methods by name.
+func learnMethods(learnset map[string][]pokeapi.LearnedMove) []string {
+	methods := []string{}
+	for method := range learnset {
+		methods = append(methods, method)
+	}
+
+	sort.Slice(methods, func(i, j int) bool {
+		if (methods[i] == "level-up") != (methods[j] == "level-up") {
+			return methods[i] == "level-up"
+		}
+		return methods[i] < methods[j]
+	})
+
+	return methods
+}
+
+func optionalInt(value *int) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprint(*value)
+}
@@ -0,0 +1,145 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+// findCaught resolves a reference to caught Pokémon: a number is an ID,
+// anything else a nickname or, failing that, a species name which may match
+// several Pokémon.
+func findCaught(cfg *config, ref string) []pokeapi.Pokemon {
+	id, err := strconv.Atoi(ref)
+	if err != nil {
+		if pokemon, ok := cfg.pokedex.FindNickname(ref); ok {
+			return []pokeapi.Pokemon{pokemon}
+		}
+		return cfg.pokedex.FindSpecies(ref)
+	}
+
+	pokemon, err := cfg.pokedex.GetPokemon(id)
+	if err != nil {
+		return []pokeapi.Pokemon{}
+	}
+
+	return []pokeapi.Pokemon{pokemon}
+}
+
+// findOne resolves ref like findCaught but requires exactly one match.
+func findOne(cfg *config, ref string) (pokeapi.Pokemon, error) {
+	found := findCaught(cfg, ref)
+	switch len(found) {
+	case 0:
+		return pokeapi.Pokemon{}, fmt.Errorf("you have no Pokémon called '%s'", ref)
+	case 1:
+		return found[0], nil
+	default:
+		return pokeapi.Pokemon{}, fmt.Errorf("you have %d %s; pick one by ID", len(found), ref)
+	}
+}
+
+// markSeen records a seen Pokémon, flagging the Pokédex for saving only when
+// the Pokémon is new.
+func markSeen(cfg *config, name string) {
+	if cfg.pokedex.MarkSeen(name) {
+		cfg.unsaved = true
+	}
+}
+
+func pokemonLabel(pokemon pokeapi.Pokemon) string {
+	details := []string{}
+	if pokemon.Nickname != "" {
+		details = append(details, pokemon.Name)
+	}
+	if pokemon.Level > 0 {
+		details = append(details, fmt.Sprintf("lv %d", pokemon.Level))
+	}
+
+	label := fmt.Sprintf("#%d %s", pokemon.ID, displayName(pokemon))
+	if len(details) > 0 {
+		label += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+
+	return label
+}
+
+func displayName(pokemon pokeapi.Pokemon) string {
+	if pokemon.Nickname != "" {
+		return pokemon.Nickname
+	}
+	return pokemon.Name
+}
+
+func cmdNickname(ctx context.Context, cfg *config, args []string) error {
+	pokemon, err := findOne(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	isPokemon, err := isPokemonName(ctx, cfg, args[1])
+	if err != nil {
+		return fmt.Errorf("cannot check nickname against Pokémon names: %w", err)
+	}
+	if isPokemon {
+		return fmt.Errorf("error naming pokemon %d: %w: '%s' is the name of a Pokémon", pokemon.ID, pokeapi.ErrInvalidNickname, args[1])
+	}
+
+	named, err := cfg.pokedex.SetNickname(pokemon.ID, args[1])
+	if err != nil {
+		return fmt.Errorf("error naming pokemon %d: %w", pokemon.ID, err)
+	}
+
+	fmt.Printf("%s is now called %s.\n", pokemonLabel(pokemon), named.Nickname)
+
+	return nil
+}
+
+// isPokemonName reports whether name is any Pokémon or species, caught or
+// not. Such nicknames are refused since they would shadow the species.
+func isPokemonName(ctx context.Context, cfg *config, name string) (bool, error) {
+	for _, resource := range []string{"pokemon-species", "pokemon"} {
+		names, err := cfg.client.GetResourceList(ctx, resource)
+		if err != nil {
+			return false, fmt.Errorf("error getting %s list: %w", resource, err)
+		}
+		if slices.ContainsFunc(names, func(r pokeapi.NamedAPIResource) bool {
+			return r.Name == name
+		}) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// cmdRelease takes an ID or nickname; species names are not accepted so a
+// release never picks one of several Pokémon by accident.
+func cmdRelease(cfg *config, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		pokemon, ok := cfg.pokedex.FindNickname(args[0])
+		if !ok {
+			return fmt.Errorf("'%s' is neither an ID nor a nickname; find IDs with 'pokedex'", args[0])
+		}
+		id = pokemon.ID
+	}
+
+	pokemon, err := cfg.pokedex.RemovePokemon(id)
+	if errors.Is(err, pokeapi.ErrGetAbsentPokemon) {
+		fmt.Printf("You have no Pokémon with ID %d.\n", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error releasing pokemon %d: %w", id, err)
+	}
+
+	fmt.Printf("%s was released. Bye, %s!\n", pokemonLabel(pokemon), displayName(pokemon))
+
+	return nil
+}
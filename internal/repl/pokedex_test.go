@@ -0,0 +1,113 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestFindCaught(t *testing.T) {
+	cfg := &config{pokedex: pokeapi.NewPokedex()}
+	for _, name := range []string{"pikachu", "eevee", "pikachu"} {
+		cfg.pokedex.AddPokemon(pokeapi.Pokemon{Name: name})
+	}
+	if _, err := cfg.pokedex.SetNickname(3, "sparky"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		ref      string
+		expected []int
+	}{
+		{ref: "pikachu", expected: []int{1, 3}},
+		{ref: "eevee", expected: []int{2}},
+		{ref: "3", expected: []int{3}},
+		{ref: "sparky", expected: []int{3}},
+		{ref: "4", expected: []int{}},
+		{ref: "mew", expected: []int{}},
+	}
+
+	for _, c := range cases {
+		found := findCaught(cfg, c.ref)
+		if len(found) != len(c.expected) {
+			t.Errorf("findCaught(%s): expected %d pokemon, got %d", c.ref, len(c.expected), len(found))
+			continue
+		}
+		for i := range found {
+			if found[i].ID != c.expected[i] {
+				t.Errorf("findCaught(%s)[%d]: expected ID %d, got %d", c.ref, i, c.expected[i], found[i].ID)
+			}
+		}
+	}
+}
+
+func TestCmdNickname(t *testing.T) {
+	routes := map[string]string{
+		"/pokemon-species": `{"results": [{"name": "pikachu"}, {"name": "deoxys"}]}`,
+		"/pokemon":         `{"results": [{"name": "pikachu"}, {"name": "deoxys-attack"}]}`,
+	}
+
+	cases := []struct {
+		name     string
+		routes   map[string]string
+		nickname string
+		expected error
+	}{
+		{name: "free name", routes: routes, nickname: "sparky", expected: nil},
+		{name: "species name", routes: routes, nickname: "deoxys", expected: pokeapi.ErrInvalidNickname},
+		{name: "form name", routes: routes, nickname: "deoxys-attack", expected: pokeapi.ErrInvalidNickname},
+		{name: "invalid nickname", routes: routes, nickname: "42", expected: pokeapi.ErrInvalidNickname},
+		// Without the name lists the nickname cannot be checked, so it is refused
+		{name: "lists unavailable", routes: map[string]string{}, nickname: "sparky", expected: pokeapi.ErrNotFound},
+	}
+
+	for _, c := range cases {
+		cfg := testConfig(t, c.routes)
+		pikachu := cfg.pokedex.AddPokemon(pokeapi.Pokemon{Name: "pikachu"})
+
+		_, err := captureOutput(t, func() error {
+			return cmdNickname(context.Background(), cfg, []string{"pikachu", c.nickname})
+		})
+		if !errors.Is(err, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, err)
+		}
+
+		pokemon, _ := cfg.pokedex.GetPokemon(pikachu.ID)
+		if c.expected == nil && pokemon.Nickname != c.nickname {
+			t.Errorf("%s: expected nickname %q, got %q", c.name, c.nickname, pokemon.Nickname)
+		}
+		if c.expected != nil && pokemon.Nickname != "" {
+			t.Errorf("%s: expected no nickname, got %q", c.name, pokemon.Nickname)
+		}
+	}
+}
+
+func TestSaveOnlyWhenSeenChanges(t *testing.T) {
+	cfg := &config{
+		pokedex:  pokeapi.NewPokedex(),
+		savePath: filepath.Join(t.TempDir(), "pokedex.json"),
+	}
+
+	markSeen(cfg, "pidgey")
+	if err := dispatch(context.Background(), cfg, []string{"pokedex"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(cfg.savePath); err != nil {
+		t.Fatalf("expected newly seen pokemon to be saved: %v", err)
+	}
+
+	if err := os.Remove(cfg.savePath); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	markSeen(cfg, "pidgey")
+	if err := dispatch(context.Background(), cfg, []string{"pokedex"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(cfg.savePath); err == nil {
+		t.Error("expected no save when nothing new was seen")
+	}
+}
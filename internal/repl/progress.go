@@ -0,0 +1,139 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+type dexProgress struct {
+	generation string
+	region     string
+	total      int
+	seen       int
+	caught     int
+}
+
+func cmdProgress(ctx context.Context, cfg *config) error {
+	generations, err := getGenerations(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	speciesIDs := make(map[string]int)
+	for _, generation := range generations {
+		for _, species := range generation.PokemonSpecies {
+			id, err := pokeapi.ResourceID(species.URL)
+			if err != nil {
+				return fmt.Errorf("error reading species '%s': %w", species.Name, err)
+			}
+			speciesIDs[species.Name] = id
+		}
+	}
+
+	seen, err := resolveSpeciesIDs(ctx, cfg, cfg.pokedex.SeenNames(), speciesIDs)
+	if err != nil {
+		return err
+	}
+	caught, err := resolveSpeciesIDs(ctx, cfg, cfg.pokedex.CaughtNames(), speciesIDs)
+	if err != nil {
+		return err
+	}
+
+	perGeneration, national := tallyProgress(generations, seen, caught)
+
+	fmt.Println("Pokédex progress:")
+	for _, progress := range perGeneration {
+		fmt.Printf("\t- %s (%s): seen %d/%d, caught %d/%d\n", progress.generation, progress.region, progress.seen, progress.total, progress.caught, progress.total)
+	}
+	fmt.Printf("National dex: seen %d/%d (%s), caught %d/%d (%s)\n",
+		national.seen, national.total, percent(national.seen, national.total),
+		national.caught, national.total, percent(national.caught, national.total))
+
+	return nil
+}
+
+func getGenerations(ctx context.Context, cfg *config) ([]pokeapi.GenerationResponse, error) {
+	resources, err := cfg.client.GetResourceList(ctx, "generation")
+	if err != nil {
+		return nil, fmt.Errorf("error getting generations: %w", err)
+	}
+
+	generations := []pokeapi.GenerationResponse{}
+	for _, resource := range resources {
+		generation, err := cfg.client.GetGeneration(ctx, resource.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error getting generation: %w", err)
+		}
+		generations = append(generations, generation)
+	}
+	sort.Slice(generations, func(i, j int) bool {
+		return generations[i].ID < generations[j].ID
+	})
+
+	return generations, nil
+}
+
+// resolveSpeciesIDs maps Pokémon names to species IDs. Most Pokémon share
+// their species' name; alternate forms such as "deoxys-attack" are looked up
+// to find their species.
+func resolveSpeciesIDs(ctx context.Context, cfg *config, names []string, speciesIDs map[string]int) (map[int]bool, error) {
+	ids := make(map[int]bool)
+	for _, name := range names {
+		id, ok := speciesIDs[name]
+		if !ok {
+			pokemon, err := cfg.client.GetPokemonDetails(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("error getting pokemon details: %w", err)
+			}
+			id, ok = speciesIDs[pokemon.Species.Name]
+		}
+		if ok {
+			ids[id] = true
+		}
+	}
+
+	return ids, nil
+}
+
+// tallyProgress counts seen and caught species per generation and across the
+// national dex.
+func tallyProgress(generations []pokeapi.GenerationResponse, seen, caught map[int]bool) ([]dexProgress, dexProgress) {
+	perGeneration := []dexProgress{}
+	national := dexProgress{}
+	for _, generation := range generations {
+		progress := dexProgress{
+			generation: generation.Name,
+			region:     generation.MainRegion.Name,
+			total:      len(generation.PokemonSpecies),
+		}
+		for _, species := range generation.PokemonSpecies {
+			id, err := pokeapi.ResourceID(species.URL)
+			if err != nil {
+				continue
+			}
+			if seen[id] {
+				progress.seen++
+			}
+			if caught[id] {
+				progress.caught++
+			}
+		}
+
+		national.total += progress.total
+		national.seen += progress.seen
+		national.caught += progress.caught
+		perGeneration = append(perGeneration, progress)
+	}
+
+	return perGeneration, national
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
@@ -0,0 +1,111 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestTallyProgress(t *testing.T) {
+	species := func(id int) pokeapi.NamedAPIResource {
+		return pokeapi.NamedAPIResource{URL: "https://pokeapi.co/api/v2/pokemon-species/" + strconv.Itoa(id) + "/"}
+	}
+	generations := []pokeapi.GenerationResponse{
+		{
+			Name:           "generation-i",
+			MainRegion:     named("kanto"),
+			PokemonSpecies: []pokeapi.NamedAPIResource{species(1), species(16), species(25)},
+		},
+		{
+			Name:           "generation-ii",
+			MainRegion:     named("johto"),
+			PokemonSpecies: []pokeapi.NamedAPIResource{species(152), species(161)},
+		},
+	}
+	seen := map[int]bool{16: true, 25: true, 161: true}
+	caught := map[int]bool{25: true}
+
+	perGeneration, national := tallyProgress(generations, seen, caught)
+
+	expected := []dexProgress{
+		{generation: "generation-i", region: "kanto", total: 3, seen: 2, caught: 1},
+		{generation: "generation-ii", region: "johto", total: 2, seen: 1, caught: 0},
+	}
+	if len(perGeneration) != len(expected) {
+		t.Fatalf("expected %d generations, got %d", len(expected), len(perGeneration))
+	}
+	for i := range expected {
+		if perGeneration[i] != expected[i] {
+			t.Errorf("generation %d: expected %+v, got %+v", i, expected[i], perGeneration[i])
+		}
+	}
+
+	if national != (dexProgress{total: 5, seen: 3, caught: 1}) {
+		t.Errorf("unexpected national progress: %+v", national)
+	}
+}
+
+func TestPercent(t *testing.T) {
+	cases := []struct {
+		n        int
+		total    int
+		expected string
+	}{
+		{n: 0, total: 0, expected: "0.0%"},
+		{n: 1, total: 3, expected: "33.3%"},
+		{n: 151, total: 151, expected: "100.0%"},
+	}
+
+	for _, c := range cases {
+		if actual := percent(c.n, c.total); actual != c.expected {
+			t.Errorf("percent(%d, %d): expected %s, got %s", c.n, c.total, c.expected, actual)
+		}
+	}
+}
+
+func TestResolveSpeciesIDs(t *testing.T) {
+	cfg := testConfig(t, map[string]string{
+		"/pokemon/deoxys-attack": `{"name": "deoxys-attack", "species": {"name": "deoxys"}}`,
+		"/pokemon/missingno":     `{"name": "missingno", "species": {"name": "missingno"}}`,
+	})
+	speciesIDs := map[string]int{"pikachu": 25, "deoxys": 386}
+
+	cases := []struct {
+		names    []string
+		expected []int
+		err      error
+	}{
+		{names: []string{"pikachu"}, expected: []int{25}},
+		// Alternate forms are looked up to find their species
+		{names: []string{"pikachu", "deoxys-attack"}, expected: []int{25, 386}},
+		// A species outside the list is not counted
+		{names: []string{"missingno"}, expected: []int{}},
+		{names: []string{"deoxys-normal"}, err: pokeapi.ErrNotFound},
+	}
+
+	for _, c := range cases {
+		ids, err := resolveSpeciesIDs(context.Background(), cfg, c.names, speciesIDs)
+		if c.err != nil {
+			if !errors.Is(err, c.err) {
+				t.Errorf("%v: expected %v, got %v", c.names, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", c.names, err)
+			continue
+		}
+		if len(ids) != len(c.expected) {
+			t.Errorf("%v: expected %v, got %v", c.names, c.expected, ids)
+			continue
+		}
+		for _, id := range c.expected {
+			if !ids[id] {
+				t.Errorf("%v: expected species %d, got %v", c.names, id, ids)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func cmdRegions(ctx context.Context, cfg *config) error {
+	regions, err := cfg.client.GetResourceList(ctx, "region")
+	if err != nil {
+		return fmt.Errorf("error getting regions: %w", err)
+	}
+
+	fmt.Println("Regions:")
+	for _, region := range regions {
+		fmt.Printf("\t- %s\n", region.Name)
+	}
+
+	return nil
+}
+
+func cmdRegion(ctx context.Context, cfg *config, args []string) error {
+	region, err := cfg.client.GetRegion(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting region: %w", err)
+	}
+
+	fmt.Printf("Region: %s (%s)\n", region.Name, region.MainGeneration.Name)
+	if cfg.versionGroup != "" && !slices.ContainsFunc(region.VersionGroups, func(group pokeapi.NamedAPIResource) bool {
+		return group.Name == cfg.versionGroup
+	}) {
+		fmt.Printf("%s cannot be visited in %s.\n", region.Name, cfg.version)
+		return nil
+	}
+	if len(region.Locations) == 0 {
+		fmt.Println("No known locations.")
+		return nil
+	}
+	fmt.Println("Locations:")
+	for _, location := range region.Locations {
+		fmt.Printf("\t- %s\n", location.Name)
+	}
+
+	return nil
+}
+
+func cmdLocation(ctx context.Context, cfg *config, args []string) error {
+	location, err := cfg.client.GetLocation(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting location: %w", err)
+	}
+
+	fmt.Printf("Location: %s\n", location.Name)
+	if location.Region.Name != "" {
+		fmt.Printf("Region: %s\n", location.Region.Name)
+	}
+	if len(location.Areas) == 0 {
+		fmt.Println("No areas to explore.")
+		return nil
+	}
+	fmt.Println("Areas:")
+	for _, area := range location.Areas {
+		fmt.Printf("\t- %s\n", area.Name)
+	}
+
+	return nil
+}
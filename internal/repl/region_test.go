@@ -0,0 +1,78 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestRegionDrillDown(t *testing.T) {
+	cfg := testConfig(t, map[string]string{
+		"/region": `{"results": [{"name": "kanto"}, {"name": "johto"}]}`,
+		"/region/kanto": `{"name": "kanto", "main_generation": {"name": "generation-i"},
+			"locations": [{"name": "pallet-town"}, {"name": "viridian-forest"}]}`,
+		"/region/hisui":          `{"name": "hisui", "main_generation": {"name": "generation-viii"}, "locations": []}`,
+		"/location/pallet-town":  `{"name": "pallet-town", "region": {"name": "kanto"}, "areas": [{"name": "pallet-town-area"}]}`,
+		"/location/mystery-zone": `{"name": "mystery-zone", "areas": []}`,
+	})
+
+	cases := []struct {
+		name     string
+		run      func() error
+		expected []string
+		err      error
+	}{
+		{
+			name:     "regions",
+			run:      func() error { return cmdRegions(context.Background(), cfg) },
+			expected: []string{"\t- kanto", "\t- johto"},
+		},
+		{
+			name:     "region",
+			run:      func() error { return cmdRegion(context.Background(), cfg, []string{"kanto"}) },
+			expected: []string{"Region: kanto (generation-i)", "\t- pallet-town", "\t- viridian-forest"},
+		},
+		{
+			name:     "region without locations",
+			run:      func() error { return cmdRegion(context.Background(), cfg, []string{"hisui"}) },
+			expected: []string{"No known locations."},
+		},
+		{
+			name:     "location",
+			run:      func() error { return cmdLocation(context.Background(), cfg, []string{"pallet-town"}) },
+			expected: []string{"Location: pallet-town", "Region: kanto", "\t- pallet-town-area"},
+		},
+		{
+			name:     "location without areas",
+			run:      func() error { return cmdLocation(context.Background(), cfg, []string{"mystery-zone"}) },
+			expected: []string{"No areas to explore."},
+		},
+		{
+			name: "unknown location",
+			run:  func() error { return cmdLocation(context.Background(), cfg, []string{"pallet"}) },
+			err:  pokeapi.ErrNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		output, err := captureOutput(t, c.run)
+		if c.err != nil {
+			if !errors.Is(err, c.err) {
+				t.Errorf("%s: expected %v, got %v", c.name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		for _, line := range c.expected {
+			if !strings.Contains(output, line) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", c.name, line, output)
+			}
+		}
+	}
+}
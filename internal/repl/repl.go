@@ -2,35 +2,112 @@ package repl
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
+	"log"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/bekadoux/pokedex/internal/pokeapi"
+	"github.com/bekadoux/pokedex/internal/pokecache"
 )
 
-func StartREPL() {
+// Options configures a REPL session.
+type Options struct {
+	// SavePath is the file the Pokédex is loaded from and saved to.
+	SavePath string
+	// CacheDir holds the persistent response cache. Empty disables it.
+	CacheDir string
+	// BaseURL overrides the PokeAPI location, e.g. to use a local mirror.
+	BaseURL string
+	// Retries is how many times a failed request is retried.
+	Retries int
+	// RateLimit caps requests per second, allowing bursts of RateBurst.
+	// Zero disables throttling.
+	RateLimit float64
+	RateBurst int
+	// Verbose prints client debug output, such as rate limiter waits.
+	Verbose bool
+}
+
+// PokeAPI documents rarely change, so disk entries are kept for a long time
+const diskCacheMaxAge = 30 * 24 * time.Hour
+
+func StartREPL(opts Options) error {
+	pokedex, err := pokeapi.LoadPokedex(opts.SavePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		pokedex = pokeapi.NewPokedex()
+	} else if err != nil {
+		return fmt.Errorf("error loading pokedex: %w", err)
+	}
+
+	retryPolicy := pokeapi.DefaultRetryPolicy
+	retryPolicy.MaxAttempts = opts.Retries + 1
+
+	clientOpts := []pokeapi.Option{
+		pokeapi.WithRetryPolicy(retryPolicy),
+		pokeapi.WithRateLimit(opts.RateLimit, opts.RateBurst),
+	}
+	if opts.Verbose {
+		clientOpts = append(clientOpts, pokeapi.WithLogger(log.New(os.Stderr, "debug: ", log.Ltime|log.Lmicroseconds)))
+	}
+	if opts.BaseURL != "" {
+		clientOpts = append(clientOpts, pokeapi.WithBaseURL(opts.BaseURL))
+	}
+	if opts.CacheDir != "" {
+		disk, err := pokecache.NewDiskCache(opts.CacheDir, diskCacheMaxAge)
+		if err != nil {
+			fmt.Printf("Warning: disk cache disabled: %v\n", err)
+		} else {
+			clientOpts = append(clientOpts, pokeapi.WithDiskCache(disk))
+		}
+	}
+
 	scanner := bufio.NewScanner(os.Stdin)
 	cfg := &config{
-		client:  pokeapi.NewClient(10 * time.Second),
-		pokedex: pokeapi.NewPokedex(),
+		client:   pokeapi.NewClient(10*time.Second, clientOpts...),
+		pokedex:  pokedex,
+		savePath: opts.SavePath,
 	}
+	defer cfg.client.Close()
+
+	interrupts := newInterruptHandler()
+	defer interrupts.stop()
 
 	for {
 		fmt.Print("Pokedex > ")
-		scanner.Scan()
+		if !scanner.Scan() {
+			fmt.Println()
+			break
+		}
 
 		input := scanner.Text()
 		clean := cleanInput(input)
 
-		err := dispatch(cfg, clean)
+		ctx, done := interrupts.commandContext()
+		err := dispatch(ctx, cfg, clean)
+		done()
+
+		if errors.Is(err, errExit) {
+			break
+		}
+		if errors.Is(err, context.Canceled) {
+			fmt.Println("\nCancelled.")
+			continue
+		}
 		if err != nil {
 			fmt.Printf("Error: ")
 			fmt.Println(err)
 		}
 	}
+
+	fmt.Println("Closing the Pokedex... Goodbye!")
+
+	return nil
 }
 
 func cleanInput(text string) []string {
@@ -40,7 +117,30 @@ func cleanInput(text string) []string {
 	return clean
 }
 
-func dispatch(cfg *config, input []string) error {
+// parseFlags separates "--name" and "--name=value" flags from positional
+// arguments. Flags not listed in known are rejected.
+func parseFlags(args []string, known ...string) ([]string, map[string]string, error) {
+	positional := []string{}
+	flags := make(map[string]string)
+
+	for _, arg := range args {
+		flag, ok := strings.CutPrefix(arg, "--")
+		if !ok {
+			positional = append(positional, arg)
+			continue
+		}
+
+		name, value, _ := strings.Cut(flag, "=")
+		if !slices.Contains(known, name) {
+			return nil, nil, fmt.Errorf("unknown option: '%s'", arg)
+		}
+		flags[name] = value
+	}
+
+	return positional, flags, nil
+}
+
+func dispatch(ctx context.Context, cfg *config, input []string) error {
 	if len(input) == 0 {
 		return errors.New("empty input")
 	}
@@ -60,5 +160,18 @@ func dispatch(cfg *config, input []string) error {
 		return fmt.Errorf("%s expects between %d and %d arguments, got %d", cmdName, calledCmd.minArgs, calledCmd.maxArgs, len(args))
 	}
 
-	return calledCmd.callback(cfg, args)
+	err := calledCmd.callback(ctx, cfg, args)
+	if err != nil {
+		return withSuggestions(ctx, cfg, err)
+	}
+
+	if calledCmd.mutates || cfg.unsaved {
+		err = cfg.pokedex.Save(cfg.savePath)
+		if err != nil {
+			return fmt.Errorf("error saving pokedex: %w", err)
+		}
+		cfg.unsaved = false
+	}
+
+	return nil
 }
@@ -1,6 +1,72 @@
 package repl
 
-import "testing"
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+// testConfig returns a config whose client talks to a test server serving
+// routes, keyed by path below the API root such as "/pokemon/pikachu".
+// Unknown paths are answered with 404.
+func testConfig(t *testing.T, routes map[string]string) *config {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[strings.TrimPrefix(r.URL.Path, "/api/v2")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := pokeapi.NewClient(
+		time.Second,
+		pokeapi.WithBaseURL(server.URL+"/api/v2"),
+		pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{MaxAttempts: 1}),
+	)
+	t.Cleanup(client.Close)
+
+	return &config{
+		client:  client,
+		pokedex: pokeapi.NewPokedex(),
+	}
+}
+
+// captureOutput returns what f prints to stdout along with its error.
+func captureOutput(t *testing.T, f func() error) (string, error) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		output <- string(data)
+	}()
+
+	callbackErr := f()
+	w.Close()
+
+	return <-output, callbackErr
+}
 
 func TestCleanInput(t *testing.T) {
 	cases := []struct {
@@ -48,3 +114,44 @@ func TestCleanInput(t *testing.T) {
 		}
 	}
 }
+
+func TestCmdInspectWithoutDetails(t *testing.T) {
+	cfg := testConfig(t, map[string]string{})
+	cfg.pokedex.AddPokemon(pokeapi.Pokemon{
+		Name:   "pikachu",
+		Height: 4,
+		Types:  []pokeapi.PokemonType{{Type: pokeapi.NamedAPIResource{Name: "electric"}}},
+	})
+
+	output, err := captureOutput(t, func() error {
+		return cmdInspect(context.Background(), cfg, []string{"pikachu"})
+	})
+	if err != nil {
+		t.Fatalf("expected inspect to work from the stored record, got %v", err)
+	}
+	for _, line := range []string{"ID: 1", "Name: pikachu", "Height: 4", "\t- electric", "Further details are unavailable"} {
+		if !strings.Contains(output, line) {
+			t.Errorf("expected output to contain %q, got:\n%s", line, output)
+		}
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	positional, flags, err := parseFlags([]string{"pallet-town-area", "--items", "--version=red"}, "items", "version")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(positional) != 1 || positional[0] != "pallet-town-area" {
+		t.Errorf("unexpected positional arguments: %v", positional)
+	}
+	if value, ok := flags["items"]; !ok || value != "" {
+		t.Errorf("expected items flag without value, got %v", flags)
+	}
+	if flags["version"] != "red" {
+		t.Errorf("expected version flag 'red', got '%s'", flags["version"])
+	}
+
+	if _, _, err := parseFlags([]string{"--unknown"}, "items"); err == nil {
+		t.Errorf("expected error for unknown flag")
+	}
+}
@@ -0,0 +1,94 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+const maxSuggestions = 3
+
+// withSuggestions adds "did you mean" hints to errors about unknown
+// resources. Any failure to build suggestions leaves err untouched.
+func withSuggestions(ctx context.Context, cfg *config, err error) error {
+	var notFound *pokeapi.NotFoundError
+	if !errors.As(err, &notFound) {
+		return err
+	}
+
+	resources, listErr := cfg.client.GetResourceList(ctx, notFound.Resource)
+	if listErr != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.Name)
+	}
+
+	suggestions := suggest(notFound.Name, names)
+	if len(suggestions) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%w\nDid you mean: %s?", err, strings.Join(suggestions, ", "))
+}
+
+// suggest returns up to maxSuggestions candidates closest to name by edit
+// distance, ignoring candidates too different to be a typo.
+func suggest(name string, candidates []string) []string {
+	maxDistance := max(1, len([]rune(name))/3)
+
+	type match struct {
+		name     string
+		distance int
+	}
+	matches := []match{}
+	for _, candidate := range candidates {
+		distance := levenshtein(name, candidate)
+		if distance <= maxDistance {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	suggestions := []string{}
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, matches[i].name)
+	}
+
+	return suggestions
+}
+
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(t)]
+}
@@ -0,0 +1,47 @@
+package repl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "pikachu", b: "pikachu", expected: 0},
+		{a: "pikachu", b: "pikachuu", expected: 1},
+		{a: "pikachu", b: "pikahcu", expected: 2},
+		{a: "", b: "abc", expected: 3},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "flabébé", b: "flabebe", expected: 2},
+	}
+
+	for _, c := range cases {
+		if actual := levenshtein(c.a, c.b); actual != c.expected {
+			t.Errorf("levenshtein(%s, %s): expected %d, got %d", c.a, c.b, c.expected, actual)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"pikachu", "pichu", "raichu", "bulbasaur", "charmander", "charmeleon"}
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "pikachuu", expected: []string{"pikachu"}},
+		{input: "charmandr", expected: []string{"charmander"}},
+		{input: "charmelon", expected: []string{"charmeleon"}},
+		{input: "mewtwo", expected: []string{}},
+	}
+
+	for _, c := range cases {
+		actual := suggest(c.input, candidates)
+		if !slices.Equal(actual, c.expected) {
+			t.Errorf("suggest(%s): expected %v, got %v", c.input, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+var errNoArea = errors.New("you are not in a location area; go to one with 'travel <area>'")
+
+func cmdTravel(ctx context.Context, cfg *config, args []string) error {
+	area, err := cfg.client.GetLocationDetails(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting location area: %w", err)
+	}
+
+	cfg.area = &area
+	cfg.wild = nil
+
+	fmt.Printf("You arrived at %s.\n", area.Name)
+	if len(filterEncounters(area.PokemonEncounters, cfg.version)) == 0 {
+		fmt.Println("There seem to be no wild Pokémon here.")
+	} else {
+		fmt.Println("Look for wild Pokémon with the encounter command.")
+	}
+
+	return nil
+}
+
+func cmdEncounter(cfg *config) error {
+	if cfg.area == nil {
+		return errNoArea
+	}
+
+	wild, ok := pokeapi.RollEncounter(cfg.area.PokemonEncounters, cfg.version)
+	if !ok {
+		fmt.Printf("There are no wild Pokémon in %s.\n", cfg.area.Name)
+		return nil
+	}
+	cfg.wild = &wild
+	markSeen(cfg, wild.Pokemon.Name)
+
+	fmt.Printf("A wild %s appeared! (%s)\n", wild.Pokemon.Name, wild.Encounter.Method.Name)
+
+	return nil
+}
+
+// wildTarget decides which wild Pokémon a catch is aimed at: the current
+// wild encounter, or any Pokémon living in the current area, rolled from its
+// encounter slots. current reports whether it is the current wild encounter.
+func wildTarget(cfg *config, args []string) (wild pokeapi.WildEncounter, current bool, err error) {
+	if cfg.area == nil {
+		return pokeapi.WildEncounter{}, false, errNoArea
+	}
+
+	if len(args) == 0 {
+		if cfg.wild == nil {
+			return pokeapi.WildEncounter{}, false, errors.New("there is no wild Pokémon to catch; look for one with 'encounter'")
+		}
+		return *cfg.wild, true, nil
+	}
+
+	name := args[0]
+	if cfg.wild != nil && cfg.wild.Pokemon.Name == name {
+		return *cfg.wild, true, nil
+	}
+
+	candidates := []pokeapi.PokemonEncounter{}
+	for _, encounter := range cfg.area.PokemonEncounters {
+		if encounter.Pokemon.Name == name {
+			candidates = append(candidates, encounter)
+		}
+	}
+
+	wild, ok := pokeapi.RollEncounter(candidates, cfg.version)
+	if !ok {
+		return pokeapi.WildEncounter{}, false, fmt.Errorf("there is no wild %s in %s", name, cfg.area.Name)
+	}
+
+	return wild, false, nil
+}
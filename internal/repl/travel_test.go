@@ -0,0 +1,135 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func TestWildTarget(t *testing.T) {
+	cfg := &config{}
+	if _, _, err := wildTarget(cfg, []string{"pidgey"}); !errors.Is(err, errNoArea) {
+		t.Errorf("expected errNoArea, got %v", err)
+	}
+
+	cfg.area = &pokeapi.LocationDetailsResponse{
+		Name: "route-1-area",
+		PokemonEncounters: []pokeapi.PokemonEncounter{
+			{
+				Pokemon: named("pidgey"),
+				VersionDetails: []pokeapi.VersionEncounterDetail{
+					{Version: named("red"), EncounterDetails: []pokeapi.Encounter{{Method: named("walk"), Chance: 50, MinLevel: 2, MaxLevel: 5}}},
+				},
+			},
+		},
+	}
+
+	if _, _, err := wildTarget(cfg, nil); err == nil {
+		t.Errorf("expected error without a wild encounter")
+	}
+
+	wild, current, err := wildTarget(cfg, []string{"pidgey"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if current || wild.Pokemon.Name != "pidgey" || wild.Encounter.MaxLevel != 5 {
+		t.Errorf("unexpected encounter: %+v", wild)
+	}
+
+	if _, _, err := wildTarget(cfg, []string{"mew"}); err == nil {
+		t.Errorf("expected error for a Pokémon not in the area")
+	}
+
+	cfg.version = "blue"
+	if _, _, err := wildTarget(cfg, []string{"pidgey"}); err == nil {
+		t.Errorf("expected error for a Pokémon not in the selected version")
+	}
+
+	cfg.wild = &pokeapi.WildEncounter{Pokemon: named("rattata")}
+	if wild, current, err := wildTarget(cfg, nil); err != nil || !current || wild.Pokemon.Name != "rattata" {
+		t.Errorf("expected current wild encounter, got %+v, %v", wild, err)
+	}
+}
+
+// catchUntilCaught repeats a catch until it succeeds; with the capture rates
+// used here every throw has a 95% chance.
+func catchUntilCaught(t *testing.T, cfg *config, args []string) string {
+	t.Helper()
+
+	for range 20 {
+		output, err := captureOutput(t, func() error {
+			return cmdCatch(context.Background(), cfg, args)
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(output, "escaped") {
+			return output
+		}
+	}
+	t.Fatalf("%v was never caught", args)
+
+	return ""
+}
+
+func TestCmdCatchClearsOnlyCurrentWild(t *testing.T) {
+	area := pokeapi.LocationDetailsResponse{
+		Name: "route-1-area",
+		PokemonEncounters: []pokeapi.PokemonEncounter{
+			{
+				Pokemon: named("pidgey"),
+				VersionDetails: []pokeapi.VersionEncounterDetail{
+					{Version: named("red"), EncounterDetails: []pokeapi.Encounter{{Method: named("walk"), Chance: 50, MinLevel: 2, MaxLevel: 5}}},
+				},
+			},
+		},
+	}
+	routes := map[string]string{
+		"/pokemon/pidgey":          `{"name": "pidgey", "species": {"name": "pidgey"}}`,
+		"/pokemon-species/pidgey":  `{"name": "pidgey", "capture_rate": 255}`,
+		"/pokemon/rattata":         `{"name": "rattata", "species": {"name": "rattata"}}`,
+		"/pokemon-species/rattata": `{"name": "rattata", "capture_rate": 255}`,
+	}
+
+	cases := []struct {
+		name     string
+		args     []string
+		wild     string
+		expected string
+	}{
+		{
+			name:     "current wild Pokémon",
+			args:     nil,
+			wild:     "",
+			expected: "rattata (lv 0) was caught",
+		},
+		{
+			name:     "named roll",
+			args:     []string{"pidgey"},
+			wild:     "rattata",
+			expected: "A wild pidgey appeared! (walk)",
+		},
+	}
+
+	for _, c := range cases {
+		cfg := testConfig(t, routes)
+		cfg.area = &area
+		cfg.wild = &pokeapi.WildEncounter{Pokemon: named("rattata")}
+
+		output := catchUntilCaught(t, cfg, c.args)
+		if !strings.Contains(output, c.expected) {
+			t.Errorf("%s: expected output to contain %q, got:\n%s", c.name, c.expected, output)
+		}
+
+		wild := ""
+		if cfg.wild != nil {
+			wild = cfg.wild.Pokemon.Name
+		}
+		if wild != c.wild {
+			t.Errorf("%s: expected wild Pokémon %q after the catch, got %q", c.name, c.wild, wild)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+)
+
+func cmdVersion(ctx context.Context, cfg *config, args []string) error {
+	if len(args) == 0 {
+		if cfg.version == "" {
+			fmt.Println("No game version selected; showing data for all versions.")
+		} else {
+			fmt.Printf("Game version: %s (%s)\n", cfg.version, cfg.versionGroup)
+		}
+		return nil
+	}
+
+	if args[0] == "all" {
+		cfg.version = ""
+		cfg.versionGroup = ""
+		cfg.generation = 0
+		cfg.versionGroupOrder = 0
+		cfg.typeChart = nil
+		cfg.wild = nil
+		fmt.Println("Showing data for all versions.")
+		return nil
+	}
+
+	version, err := cfg.client.GetVersion(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("error getting version: %w", err)
+	}
+	versionGroup, err := cfg.client.GetVersionGroup(ctx, version.VersionGroup.Name)
+	if err != nil {
+		return fmt.Errorf("error getting version group: %w", err)
+	}
+	generation, err := pokeapi.ResourceID(versionGroup.Generation.URL)
+	if err != nil {
+		return fmt.Errorf("error finding generation of '%s': %w", versionGroup.Name, err)
+	}
+
+	cfg.version = version.Name
+	cfg.versionGroup = versionGroup.Name
+	cfg.generation = generation
+	cfg.versionGroupOrder = versionGroup.Order
+	// Type relations differ between generations
+	cfg.typeChart = nil
+	// The current wild Pokémon may not exist in the new version
+	cfg.wild = nil
+
+	fmt.Printf("Game version set to %s.\n", cfg.version)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+var effectivenessCategories = []struct {
+	label      string
+	multiplier float64
+}{
+	{label: "4x", multiplier: 4},
+	{label: "2x", multiplier: 2},
+	{label: "½x", multiplier: 0.5},
+	{label: "¼x", multiplier: 0.25},
+	{label: "Immune", multiplier: 0},
+}
+
+func cmdWeakness(ctx context.Context, cfg *config, args []string) error {
+	name := args[0]
+	types, err := pokemonTypes(ctx, cfg, name)
+	if err != nil {
+		return err
+	}
+
+	if cfg.typeChart == nil {
+		chart, err := cfg.client.GetTypeChart(ctx, cfg.generation)
+		if err != nil {
+			return fmt.Errorf("error building type chart: %w", err)
+		}
+		cfg.typeChart = &chart
+	}
+
+	fmt.Printf("Damage taken by %s (%s):\n", name, strings.Join(types, "/"))
+	for _, category := range effectivenessCategories {
+		attackers := []string{}
+		for _, attack := range cfg.typeChart.Types() {
+			if cfg.typeChart.Multiplier(attack, types...) == category.multiplier {
+				attackers = append(attackers, attack)
+			}
+		}
+		if len(attackers) > 0 {
+			fmt.Printf("%s: %s\n", category.label, strings.Join(attackers, ", "))
+		}
+	}
+
+	return nil
+}
+
+// pokemonTypes accepts caught Pokémon by ID or nickname as well as any
+// Pokémon by name, and returns its types in the selected version.
+func pokemonTypes(ctx context.Context, cfg *config, name string) ([]string, error) {
+	if caught := findCaught(cfg, name); len(caught) > 0 {
+		name = caught[0].Name
+	}
+
+	response, err := cfg.client.GetPokemonDetails(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pokemon details: %w", err)
+	}
+
+	types := []string{}
+	for _, pType := range response.TypesIn(cfg.generation) {
+		types = append(types, pType.Type.Name)
+	}
+
+	return types, nil
+}
@@ -1,7 +1,72 @@
 package main
 
-import "github.com/bekadoux/pokedex/internal/repl"
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bekadoux/pokedex/internal/pokeapi"
+	"github.com/bekadoux/pokedex/internal/repl"
+)
 
 func main() {
-	repl.StartREPL()
+	savePath := flag.String("save", os.Getenv("POKEDEX_SAVE"), "path to the Pokédex save file (env POKEDEX_SAVE)")
+	cacheDir := flag.String("cache-dir", os.Getenv("POKEDEX_CACHE_DIR"), "directory for cached PokeAPI responses (env POKEDEX_CACHE_DIR)")
+	baseURL := flag.String("api-url", os.Getenv("POKEDEX_API_URL"), "PokeAPI base URL, e.g. a local mirror (env POKEDEX_API_URL)")
+	retries := flag.Int("retries", pokeapi.DefaultRetryPolicy.MaxAttempts-1, "how many times to retry failed PokeAPI requests")
+	rateLimit := flag.Float64("rate", pokeapi.DefaultRateLimit, "maximum PokeAPI requests per second (0 disables throttling)")
+	rateBurst := flag.Int("burst", pokeapi.DefaultRateBurst, "maximum burst of PokeAPI requests")
+	verbose := flag.Bool("verbose", false, "print debug output such as requests and rate limiter waits")
+	noDiskCache := flag.Bool("no-disk-cache", false, "keep PokeAPI responses in memory only")
+	flag.Parse()
+
+	if *savePath == "" {
+		dataDir, err := defaultDataDir()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		*savePath = filepath.Join(dataDir, "pokedex", "pokedex.json")
+	}
+
+	if *noDiskCache {
+		*cacheDir = ""
+	} else if *cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		*cacheDir = filepath.Join(userCacheDir, "pokedex")
+	}
+
+	err := repl.StartREPL(repl.Options{
+		SavePath:  *savePath,
+		CacheDir:  *cacheDir,
+		BaseURL:   *baseURL,
+		Retries:   max(*retries, 0),
+		RateLimit: *rateLimit,
+		RateBurst: *rateBurst,
+		Verbose:   *verbose,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// defaultDataDir follows the XDG base directory spec, falling back to
+// ~/.local/share when XDG_DATA_HOME is unset.
+func defaultDataDir() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return dir, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error locating home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".local", "share"), nil
 }